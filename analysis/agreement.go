@@ -0,0 +1,117 @@
+package analysis
+
+import (
+	"strings"
+)
+
+/* the flag of nouns denoting people (çocuk, öğretmen, Ayşe), whose plural takes a plural verb */
+const Human = "HUMAN"
+
+/*
+An Agreement is a policy for the person of a verb whose subject is a third person plural, where the plural
+personal suffix -lAr is optional: çocuklar geldiler and çocuklar geldi are both correct.
+*/
+type Agreement int
+
+const (
+	AnimacyAgreement  Agreement = iota /* a plural verb only with a human subject (see Animate): çocuklar geldiler, kitaplar geldi */
+	PluralAgreement                    /* always a plural verb: kitaplar geldiler */
+	SingularAgreement                  /* never a plural verb: çocuklar geldi */
+)
+
+/* the persons of the personal pronouns other than o, which is a third person */
+var pronounPersons = map[string]string{"ben": "1sg", "sen": "2sg", "biz": "1pl", "siz": "2pl"}
+
+/* the derivational suffixes forming nouns of people */
+var agentSuffixes = map[string]bool{"N.N.CI": true, "N.V.ICI": true, "N.V.MAN": true}
+
+/*
+Reports whether the analysis of a nominal denotes people, by a heuristic: the personal pronouns, kinship
+groups (teyzemgiller), nouns derived with -CI, -(y)IcI and -mAn (gazeteci, okuyucu, öğretmen) and otherwise
+roots flagged Human. Animals and things are not animate for agreement: kediler geldi, kitaplar düştü.
+*/
+func Animate(a Analysis) bool {
+	human := contains(a.Flags, Human)
+	if a.POS == "PRON" {
+		human = pronounPersons[a.Lemma()] != "" || a.Lemma() == "o"
+	}
+	for _, tag := range a.Tags {
+		if _, _, ok := Derivation(tag); ok {
+			human = agentSuffixes[tag]
+		} else if strings.HasPrefix(tag, "KIN.") {
+			human = true
+		}
+	}
+	return human
+}
+
+/* reports whether the analysis of a nominal is plural: its last stem has the suffix PL or KIN.PL */
+func plural(a Analysis) bool {
+	pl := false
+	for _, tag := range a.Tags {
+		if _, _, ok := Derivation(tag); ok {
+			pl = false
+		} else if tag == "PL" || tag == "KIN.PL" {
+			pl = true
+		}
+	}
+	return pl
+}
+
+/*
+Returns the person (1sg, ..., 3pl) of a verb whose subject has the analysis: the person of a personal pronoun
+(biz, bizler: 1pl), 3sg for other singular subjects, and for a third person plural 3pl or 3sg as decided
+by Options.Agreement.
+*/
+func (an *Analyzer) SubjectPerson(subject Analysis) string {
+	if subject.POS == "PRON" {
+		if p, ok := pronounPersons[subject.Lemma()]; ok {
+			return p
+		}
+	}
+	if !plural(subject) {
+		return "3sg"
+	}
+	switch an.Options.Agreement {
+	case PluralAgreement:
+		return "3pl"
+	case SingularAgreement:
+		return "3sg"
+	}
+	if Animate(subject) {
+		return "3pl"
+	}
+	return "3sg"
+}
+
+/*
+Generates a verb of the lexicon as Generate, with its last personal suffix (PRED.3sg, VB.1pl, IMP.3sg, ...)
+replaced by the suffix of the person of the subject (see SubjectPerson):
+gel + TAM.PPFV.KNWN VB.3sg is geldiler with the subject çocuklar and geldi with the subject kitaplar.
+Returns false if the tags have no personal suffix or there is none of that person.
+*/
+func (an *Analyzer) Agree(e Entry, tags []string, subject Analysis) (Analysis, bool) {
+	person := an.SubjectPerson(subject)
+	for i := len(tags) - 1; i >= 0; i-- {
+		j := strings.LastIndexByte(tags[i], '.')
+		if j < 0 || !isPerson(tags[i][j+1:]) {
+			continue
+		}
+		agreed := append([]string(nil), tags...)
+		agreed[i] = tags[i][:j+1] + person
+		if len(an.Catalog.Match(agreed[i])) == 0 {
+			return Analysis{}, false
+		}
+		return an.Generate(e, agreed)
+	}
+	return Analysis{}, false
+}
+
+/* reports whether the last key of a tag names a person */
+func isPerson(key string) bool {
+	switch key {
+	case "1sg", "2sg", "3sg", "1pl", "2pl", "3pl":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSubjectPerson(t *testing.T) {
+	an := load(t)
+	subjects := []string{
+		"çocuk[NOUN]+PL+CASE.ABSL", "kitap[NOUN]+PL+CASE.ABSL", "kedi[NOUN]+PL+CASE.ABSL", "çocuk[NOUN]+CASE.ABSL",
+		"öğretmen[NOUN]+PL+POS.1pl+CASE.ABSL", "gazete[NOUN]+N.N.CI+PL+CASE.ABSL", "teyze[NOUN]+POS.1sg+KIN.FAML+KIN.PL+CASE.ABSL",
+		"kitap[NOUN]+N.N.LIK+PL+CASE.ABSL", "o[PRON]+PL+CASE.ABSL", "biz[PRON]+PL+CASE.ABSL", "sen[PRON]+CASE.ABSL",
+	}
+	persons := map[Agreement][]string{
+		AnimacyAgreement:  {"3pl", "3sg", "3sg", "3sg", "3pl", "3pl", "3pl", "3sg", "3pl", "1pl", "2sg"},
+		PluralAgreement:   {"3pl", "3pl", "3pl", "3sg", "3pl", "3pl", "3pl", "3pl", "3pl", "1pl", "2sg"},
+		SingularAgreement: {"3sg", "3sg", "3sg", "3sg", "3sg", "3sg", "3sg", "3sg", "3sg", "1pl", "2sg"},
+	}
+	for policy, valid_out := range persons {
+		an.Options.Agreement = policy
+		for i, s := range subjects {
+			a, ok := an.ParseAnalysis(s)
+			if !ok {
+				t.Fatalf("ParseAnalysis(%s) failed", s)
+			}
+			if p := an.SubjectPerson(a); p != valid_out[i] {
+				t.Errorf("SubjectPerson(%s) with policy %d = %s, expected %s", s, policy, p, valid_out[i])
+			}
+		}
+	}
+}
+
+func TestAgree(t *testing.T) {
+	an := load(t)
+	gel := an.Entries("gel", "VERB")[0]
+	valid := [][2]string{
+		{"TAM.PPFV.KNWN VB.3sg", "çocuk[NOUN]+PL+CASE.ABSL"},
+		{"TAM.PPFV.KNWN VB.3sg", "kitap[NOUN]+PL+CASE.ABSL"},
+		{"TAM.PRS.IPFV PRED.3sg", "biz[PRON]+CASE.ABSL"},
+		{"TAM.PRS.IPFV COP.PAST.KNWN VB.3sg", "öğrenci[NOUN]+PL+CASE.ABSL"},
+		{"IMP.3sg", "asker[NOUN]+PL+CASE.ABSL"},
+	}
+	valid_out := []string{"geldiler", "geldi", "geliyoruz", "geliyordular", "gelsinler"}
+	for i, v := range valid {
+		subject, _ := an.ParseAnalysis(v[1])
+		if a, ok := an.Agree(gel, strings.Fields(v[0]), subject); !ok || a.Word.String() != valid_out[i] {
+			t.Errorf("Agree(gel, %s, %s) = (%s, %v), expected %s", v[0], v[1], a.Word, ok, valid_out[i])
+		}
+	}
+
+	invalid := [][2]string{
+		{"TAM.PPFV.KNWN", "çocuk[NOUN]+PL+CASE.ABSL"}, /* no personal suffix */
+		{"IMP.3sg", "ben[PRON]+CASE.ABSL"},            /* no IMP.1sg */
+	}
+	for _, v := range invalid {
+		subject, _ := an.ParseAnalysis(v[1])
+		if a, ok := an.Agree(gel, strings.Fields(v[0]), subject); ok {
+			t.Errorf("Agree(gel, %s, %s) = %s, expected to fail", v[0], v[1], a.Word)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package analysis
+
+import (
+	"path/filepath"
+	"strings"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+	"github.com/kaan9/turkish-morphology/normalize"
+	"github.com/kaan9/turkish-morphology/pronouns"
+)
+
+/*
+An Analysis is one way of producing a word: a root of the lexicon followed by a sequence of suffixes
+given by their tags. Stems holds the stem after the root and after each suffix. Variant is set when
+the word was written in a nonstandard way (see AnalyzeWritten). A Guessed analysis has a root that is
+not in the lexicon, and Penalty lowers the confidence in an analysis (see Options.GuessRoots).
+*/
+type Analysis struct {
+	Root    inf.Root
+	POS     string
+	Flags   []string
+	Tags    []string
+	Stems   []inf.Stem
+	Word    inf.Word
+	Variant *Variant
+	Guessed bool
+	Penalty float64
+}
+
+/*
+An Analyzer finds the analyses of words by running the morphotactics forward from each lexicon root
+that could begin the word and appending suffixes with inflection.Stem.Append, keeping only the stems
+that agree with the word. Weights, if set, rank the analyses by corpus frequencies (see Rank).
+*/
+type Analyzer struct {
+	Catalog *Catalog
+	Tactics *Morphotactics
+	Lexicon *Lexicon
+	Options Options
+	Weights *Weights
+
+	/*
+		lexicon entries indexed by their root without its final (possibly unresolved) character, and pronouns
+		also by their suppletive forms without the final character (ban for ben, which becomes bana)
+	*/
+	index map[string][]int
+}
+
+/* Options change how an Analyzer analyzes words */
+type Options struct {
+	/* when a word has no analysis, propose analyses with a root that is not in the lexicon */
+	GuessRoots bool
+	/*
+		when a derived stem is itself a root of the lexicon (öğretmen, tarak), analyze it only as that root
+		and not by its derivation (öğret[VERB]+N.V.MAN)
+	*/
+	Lexicalized bool
+	/* the Word of an analysis keeps the casing of the analyzed word (İstanbul) instead of being lowercase */
+	PreserveCase bool
+	/*
+		AnalyzeWritten accepts the interrogative particle mI written attached to the word before it (geliyormusun,
+		varmı) as a variant writing of the word, whose standard writing separates the particle: geliyor musun
+	*/
+	Lenient bool
+	/* whether AnalyzeWritten accepts the plural of a foreign plural used as a singular: evraklar (see PluralPolicy) */
+	ForeignPlurals PluralPolicy
+	/* whether a verb with a third person plural subject is plural (see Agreement and Agree) */
+	Agreement Agreement
+}
+
+/* the data files read by Load */
+const (
+	CatalogFile      = "suffixes.toml"
+	MorphotacticFile = "suffix-order.txt"
+	LexiconFile      = "lexicon.txt"
+)
+
+/* creates an Analyzer from a catalog, its morphotactics and a lexicon */
+func New(c *Catalog, m *Morphotactics, lex *Lexicon) *Analyzer {
+	an := &Analyzer{Catalog: c, Tactics: m, Lexicon: lex, index: map[string][]int{}}
+	for i, e := range lex.Entries {
+		k := string(e.Root[:len(e.Root)-1])
+		an.index[k] = append(an.index[k], i)
+		if e.POS == "PRON" {
+			for _, s := range pronouns.Forms(inf.Stem(e.Root).Word().String()) {
+				k := string(s[:len(s)-1])
+				an.index[k] = append(an.index[k], i)
+			}
+		}
+	}
+	return an
+}
+
+/* creates an Analyzer from the catalog, morphotactics and lexicon files in dir */
+func Load(dir string) (*Analyzer, error) {
+	c, err := LoadCatalog(filepath.Join(dir, CatalogFile))
+	if err != nil {
+		return nil, err
+	}
+	m, err := LoadMorphotactics(filepath.Join(dir, MorphotacticFile), c)
+	if err != nil {
+		return nil, err
+	}
+	lex, err := LoadLexicon(filepath.Join(dir, LexiconFile))
+	if err != nil {
+		return nil, err
+	}
+	return New(c, m, lex), nil
+}
+
+/*
+Returns all analyses of a word in any casing, which is normalized by normalize.Lower (İstanbul -> istanbul).
+Analyses are ordered by the length of their root and then by the order of preference of the lexicon and
+the morphotactics. If there are none and Options.GuessRoots is set, returns the guessed analyses (see Guess).
+*/
+func (an *Analyzer) Analyze(word string) []Analysis {
+	return an.AnalyzeIn(nil, word)
+}
+
+/*
+Same as Analyze, but the stems, words and tags of the analyses are allocated in the arena and are only
+valid until it is Reset (see Arena)
+*/
+func (an *Analyzer) AnalyzeIn(ar *Arena, word string) []Analysis {
+	w := []rune(normalize.Lower(word))
+	var res []Analysis
+	var seen []int /* a pronoun is indexed by its root and by its suppletive forms (ben, benim) */
+	for i := 0; i <= len(w); i++ {
+		for _, j := range an.index[string(w[:i])] {
+			if containsInt(seen, j) {
+				continue
+			}
+			seen = append(seen, j)
+			an.analyzeFrom(an.Lexicon.Entries[j], w, 0, ar, &res)
+		}
+	}
+	if len(res) == 0 && an.Options.GuessRoots {
+		res = an.Guess(word)
+	}
+	if an.Options.PreserveCase {
+		for i := range res {
+			res[i].Word = inf.Word(normalize.Recase(word, res[i].Word.String()))
+		}
+	}
+	return res
+}
+
+/*
+appends the analyses of w starting from the root of entry, comparing only the characters from index from,
+allocating in the arena (which may be nil)
+*/
+func (an *Analyzer) analyzeFrom(e Entry, w []rune, from int, ar *Arena, res *[]Analysis) {
+	an.searchFrom(exact{w, from}, e, ar, res)
+}
+
+/* appends the analyses of the words accepted by the matcher starting from the root of entry */
+func (an *Analyzer) searchFrom(m matcher, e Entry, ar *Arena, res *[]Analysis) {
+	a := Analysis{Root: e.Root, POS: e.POS, Flags: e.Flags, Stems: ar.stemSlice(1)}
+	a.Stems[0] = inf.Stem(e.Root)
+	an.search(m, an.Tactics.Root(e), a, nil, ar, res)
+}
+
+/* a matcher selects the stems that a search extends and the words that it accepts */
+type matcher interface {
+	/* reports whether all but the final (unresolved) character of the stem can begin an accepted word */
+	prefix(stem inf.Stem) bool
+	word(word inf.Word) bool
+}
+
+/* matches the word w, comparing only the characters from index from */
+type exact struct {
+	w    []rune
+	from int
+}
+
+func (m exact) prefix(stem inf.Stem) bool {
+	return len(stem)-1 <= len(m.w) && agrees(stem, m.w, m.from, len(stem)-1)
+}
+
+func (m exact) word(word inf.Word) bool {
+	return len(word) == len(m.w) && agrees(inf.Stem(word), m.w, m.from, len(m.w))
+}
+
+/*
+Extends the analysis a in state with every suffix allowed by the morphotactics whose stem the matcher
+accepts. empty holds the states visited since the stem last grew so that chains of empty suffixes cannot loop.
+*/
+func (an *Analyzer) search(m matcher, state string, a Analysis, empty []string, ar *Arena, res *[]Analysis) {
+	stem := a.Stems[len(a.Stems)-1]
+	if an.Tactics.Final(state) {
+		if word := ar.word(stem); m.word(word) {
+			a.Word = word
+			*res = append(*res, a)
+		}
+	}
+	for _, tag := range an.Tactics.Next(state) {
+		if blocked(a, tag) {
+			continue
+		}
+		next := an.append(ar, a, tag)
+		if !m.prefix(next) {
+			continue
+		}
+		if an.Options.Lexicalized && an.lexicalized(tag, next) {
+			continue
+		}
+		e := []string(nil)
+		if len(next) == len(stem) {
+			if contains(empty, tag) {
+				continue
+			}
+			e = append(append(e, empty...), tag)
+		}
+		b := Analysis{Root: a.Root, POS: a.POS, Flags: a.Flags}
+		b.Tags = ar.tagSlice(len(a.Tags) + 1)
+		b.Tags[copy(b.Tags, a.Tags)] = tag
+		b.Stems = ar.stemSlice(len(a.Stems) + 1)
+		b.Stems[copy(b.Stems, a.Stems)] = next
+		an.search(m, tag, b, e, ar, res)
+	}
+}
+
+/*
+Returns the last stem of the analysis followed by the suffix with the tag, which is the suppletive form
+of a pronoun without suffixes that has one (see pronouns.Form), allocated in the arena (which may be nil).
+A pronoun that takes the genitive before the suffix gets it first: ben + (y)lA is benimle (see pronouns.TakesGenitive).
+The suffix follows the exceptions to harmony of allomorphs and the irregular persons of the negative aorist,
+and raises the e of ye and de before a buffer y (see raise).
+*/
+func (an *Analyzer) append(ar *Arena, a Analysis, tag string) inf.Stem {
+	if len(a.Tags) == 0 && a.POS == "PRON" {
+		if s, ok := pronouns.Form(a.Lemma(), tag); ok {
+			return s
+		}
+		if pronouns.TakesGenitive(a.Lemma(), tag) {
+			return ar.append(an.append(ar, a, "CASE.GEN"), an.allomorphs(a, tag))
+		}
+	}
+	if stem, allo, ok := an.negativeAorist(a, tag); ok {
+		return ar.append(stem, allo)
+	}
+	return raise(a, ar.append(a.Stems[len(a.Stems)-1], an.allomorphs(a, tag)))
+}
+
+/* reports whether the stem produced by a derivational suffix is a root of the lexicon of the same part of speech */
+func (an *Analyzer) lexicalized(tag string, stem inf.Stem) bool {
+	result, _, ok := Derivation(tag)
+	if !ok {
+		return false
+	}
+	for _, i := range an.index[string(stem[:len(stem)-1])] {
+		e := an.Lexicon.Entries[i]
+		pos := e.POS
+		if pos == "ADJ" && result == "NOUN" {
+			pos = "NOUN" /* N is a noun or adjective */
+		}
+		if pos == result && inf.Stem(e.Root).Word().String() == stem.Word().String() {
+			return true
+		}
+	}
+	return false
+}
+
+/* reports whether the stem and the word agree on the characters from index from up to to */
+func agrees(stem inf.Stem, w []rune, from, to int) bool {
+	for i := from; i < to; i++ {
+		if stem[i] != w[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsInt(xs []int, x int) bool {
+	for _, y := range xs {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
+
+/* the lemma of an analysis is its fully resolved root */
+func (a Analysis) Lemma() string {
+	return inf.Stem(a.Root).Word().String()
+}
+
+/* formats the analysis as root[POS]+TAG+TAG... */
+func (a Analysis) String() string {
+	var b strings.Builder
+	b.WriteString(a.Lemma())
+	b.WriteString("[" + a.POS + "]")
+	for _, tag := range a.Tags {
+		b.WriteString("+" + tag)
+	}
+	return b.String()
+}
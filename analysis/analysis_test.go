@@ -0,0 +1,294 @@
+package analysis
+
+import (
+	"testing"
+)
+
+func load(t testing.TB) *Analyzer {
+	an, err := Load("..")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	return an
+}
+
+/* reports whether s is the string form of one of the analyses */
+func found(as []Analysis, s string) bool {
+	for _, a := range as {
+		if a.String() == s {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAnalyze(t *testing.T) {
+	an := load(t)
+	valid := []string{
+		"ev", "evlerimizden", "kitabı", "bunların", "ona", "gidiyorum", "geldiler", "başlıyor",
+		"evdeyim", "evdekiler", "tanıştırıldı", "gelmeyecekmişsin", "yapamayabilirim", "teyzemgiller",
+		"geliyorlardı", "misin", "dördüncüsü", "ikişer", "yeşilimsi", "mavimsiler", "evimsi", "ekşimtrak",
+		"acımtrakları", "azırak", "yukarırak", "öğretici", "seçmen", "kazık", "büyüteci",
+		"güzelleşti", "evlendiler", "düzelecek", "incelmiş", "susadım", "önemsemiyor", "güzelleşmeyecekmiş",
+		"bana", "sana", "benim", "bizim", "benimki", "onlara", "dünkü", "bugünküler", "sabahki",
+		"yapamam", "yapmayız", "yapamayabilirdik", "düşeyazar", "benimle", "onunla", "kiminle",
+		"eviyle", "kitabıyla", "evleriyle", "evinle", "eviydi",
+	}
+	valid_out := []string{
+		"ev[NOUN]+CASE.ABSL",
+		"ev[NOUN]+PL+POS.1pl+CASE.ABL",
+		"kitap[NOUN]+CASE.ACC",
+		"bu[PRON]+PL+CASE.GEN",
+		"o[PRON]+CASE.DAT",
+		"git[VERB]+TAM.PRS.IPFV+PRED.1sg",
+		"gel[VERB]+TAM.PPFV.KNWN+VB.3pl",
+		"başla[VERB]+TAM.PRS.IPFV+PRED.3sg",
+		"ev[NOUN]+CASE.LOC+PRED.1sg",
+		"ev[NOUN]+CASE.LOC+REL+PL+CASE.ABSL",
+		"tanı[VERB]+VC.RECP+VC.CAUS.2+VC.PASS+TAM.PPFV.KNWN+VB.3sg",
+		"gel[VERB]+NEG.NEG+TAM.FUT+COP.PAST.INFR+PRED.2sg",
+		"yap[VERB]+NEG.INAB+VSX.ABIL+TAM.AOR.I+PRED.1sg",
+		"teyze[NOUN]+POS.1sg+KIN.FAML+KIN.PL+CASE.ABSL",
+		"gel[VERB]+TAM.PRS.IPFV+PRED.3pl+COP.PAST.KNWN+VB.3sg",
+		"mi[QUES]+PRED.2sg",
+		"dört[NUM]+NUM.ORD+POS.3sg+CASE.ABSL",
+		"iki[NUM]+NUM.DIST+CASE.ABSL",
+		"yeşil[ADJ]+N.N.IMSI+CASE.ABSL",
+		"mavi[ADJ]+N.N.IMSI+PL+CASE.ABSL",
+		"ev[NOUN]+N.N.IMSI+CASE.ABSL",
+		"ekşi[ADJ]+N.ADJ.IMTRAK+CASE.ABSL",
+		"acı[ADJ]+N.ADJ.IMTRAK+PL+CASE.ACC",
+		"az[ADV]+ADV.ADV.RAK",
+		"yukarı[ADV]+ADV.ADV.RAK",
+		"öğret[VERB]+N.V.ICI+CASE.ABSL",
+		"seç[VERB]+N.V.MAN+CASE.ABSL",
+		"kaz[VERB]+N.V.IK+CASE.ABSL",
+		"büyü[VERB]+VC.CAUS.1+N.V.AC+CASE.ACC",
+		"güzel[ADJ]+V.N.LAS+TAM.PPFV.KNWN+VB.3sg",
+		"ev[NOUN]+V.N.LAN+TAM.PPFV.KNWN+VB.3pl",
+		"düz[ADJ]+V.N.AL+TAM.FUT+PRED.3sg",
+		"ince[ADJ]+V.N.AL+TAM.PPFV.INFR+PRED.3sg",
+		"su[NOUN]+V.N.SA+TAM.PPFV.KNWN+VB.1sg",
+		"önem[NOUN]+V.N.SA+NEG.NEG+TAM.PRS.IPFV+PRED.3sg",
+		"güzel[ADJ]+V.N.LAS+NEG.NEG+TAM.FUT+COP.PAST.INFR+PRED.3sg",
+		"ben[PRON]+CASE.DAT",
+		"sen[PRON]+CASE.DAT",
+		"ben[PRON]+CASE.GEN",
+		"biz[PRON]+CASE.GEN",
+		"ben[PRON]+CASE.GEN+REL+CASE.ABSL",
+		"o[PRON]+PL+CASE.DAT",
+		"dün[NOUN]+REL+CASE.ABSL",
+		"bugün[NOUN]+REL+PL+CASE.ABSL",
+		"sabah[NOUN]+REL+CASE.ABSL",
+		"yap[VERB]+NEG.INAB+TAM.AOR.NEG+PRED.1sg",
+		"yap[VERB]+NEG.NEG+TAM.AOR.NEG+PRED.1pl",
+		"yap[VERB]+NEG.INAB+VSX.ABIL+TAM.AOR.I+COP.PAST.KNWN+VB.1pl",
+		"düş[VERB]+VSX.NEAR+TAM.AOR.A+PRED.3sg",
+		"ben[PRON]+CASE.INS",
+		"o[PRON]+CASE.INS",
+		"kim[PRON]+CASE.INS",
+		"ev[NOUN]+POS.3sg+CASE.INS",
+		"kitap[NOUN]+POS.3sg+CASE.INS",
+		"ev[NOUN]+POS.3pl+CASE.INS",
+		"ev[NOUN]+POS.2sg+CASE.INS", /* but not POS.3sg, whose n gives way to the buffer y */
+		"ev[NOUN]+POS.3sg+CASE.ABSL+COP.PAST.KNWN+VB.3sg",
+	}
+	for i, w := range valid {
+		as := an.Analyze(w)
+		if !found(as, valid_out[i]) {
+			t.Errorf("Analyze(%s) = %v, expected to contain %s", w, as, valid_out[i])
+		}
+		for _, a := range as {
+			if string(a.Word) != w || len(a.Stems) != len(a.Tags)+1 {
+				t.Errorf("Analyze(%s) = %v with word %s and %d stems", w, a, a.Word, len(a.Stems))
+			}
+		}
+	}
+
+	if found(an.Analyze("evinle"), "ev[NOUN]+POS.3sg+CASE.INS") {
+		t.Errorf("Analyze(evinle) = %v, expected no POS.3sg+CASE.INS", an.Analyze("evinle"))
+	}
+
+	invalid := []string{
+		"", "evdenin", "gelıyorum", "kitapı", "xyz", "gidiyorumlar", "evimizev", "ekşimtrek", "evimtrak",
+		"yeşilimsı", "güzellaştı", "susedim", "bene", "sene", "benin", "bizin", "dünki", "günki", "evki",
+		"sabahkı", "dündekü", "gelz", "yapabiler", "yapıverer", "düşeyazır",
+		"benle", "senle", "kimle", "bizle",
+		"güzelirek", "beyazırak", "büyüğümtrak", "güzelimtrak", "evirek", /* -(I)rAK and -(I)mtrak are limited */
+	}
+	for _, w := range invalid {
+		if as := an.Analyze(w); len(as) != 0 {
+			t.Errorf("Analyze(%s) = %v, expected no analyses", w, as)
+		}
+	}
+}
+
+/* the negative aorist has no -z in the 1sg and 1pl, neither as a tense nor as a participle used as a predicate */
+func TestNegativeAorist(t *testing.T) {
+	an := load(t)
+	for _, w := range []string{"yapmazım", "yapamazım", "yapmazız", "yapamazız", "gelmezim", "gelemeziz"} {
+		if as := an.Analyze(w); len(as) != 0 {
+			t.Errorf("Analyze(%s) = %v, expected no analyses", w, as)
+		}
+	}
+	if a, ok := an.ParseAnalysis("yap[VERB]+NEG.NEG+PTCP.IMPRS.AOR.NEG+CASE.ABSL+PRED.1sg"); ok {
+		t.Errorf("ParseAnalysis(yap[VERB]+NEG.NEG+PTCP.IMPRS.AOR.NEG+CASE.ABSL+PRED.1sg) = %v, expected no word", a)
+	}
+	if as := an.Analyze("yapmazsın"); len(as) == 0 {
+		t.Errorf("Analyze(yapmazsın) = %v, expected analyses", as)
+	}
+}
+
+func TestAorist(t *testing.T) {
+	an := load(t)
+	valid := []string{"gel", "al", "yap", "git", "oku", "çalış", "ye"}
+	valid_out := []string{"TAM.AOR.I", "TAM.AOR.I", "TAM.AOR.A", "TAM.AOR.A", "TAM.AOR.I", "TAM.AOR.I", "TAM.AOR.A"}
+	for i, lemma := range valid {
+		es := an.Entries(lemma, "VERB")
+		if len(es) == 0 {
+			t.Fatalf("%s[VERB] is not in the lexicon", lemma)
+		}
+		if tag := Aorist(es[0]); tag != valid_out[i] {
+			t.Errorf("Aorist(%s) = %s, expected %s", lemma, tag, valid_out[i])
+		}
+	}
+
+	/* a verb has only its own aorist, as a tense and as a participle */
+	for _, w := range []string{"geler", "alar", "yapır", "gidir", "okar", "gelerim", "yapırsın"} {
+		if as := an.Analyze(w); len(as) != 0 {
+			t.Errorf("Analyze(%s) = %v, expected no analyses", w, as)
+		}
+	}
+
+	/* a stem formed by a voice or verbalizing suffix only takes -Ir */
+	valid = []string{"yaptırır", "yaptırırım", "okunur", "evlenir", "güzelleşir", "yenir"}
+	valid_out = []string{
+		"yap[VERB]+VC.CAUS.2+TAM.AOR.I+PRED.3sg",
+		"yap[VERB]+VC.CAUS.2+TAM.AOR.I+PRED.1sg",
+		"oku[VERB]+VC.REFL+TAM.AOR.I+PRED.3sg",
+		"ev[NOUN]+V.N.LAN+TAM.AOR.I+PRED.3sg",
+		"güzel[ADJ]+V.N.LAS+TAM.AOR.I+PRED.3sg",
+		"ye[VERB]+VC.REFL+TAM.AOR.I+PRED.3sg",
+	}
+	for i, w := range valid {
+		if as := an.Analyze(w); !found(as, valid_out[i]) {
+			t.Errorf("Analyze(%s) = %v, expected to contain %s", w, as, valid_out[i])
+		}
+	}
+	for _, w := range []string{"yaptırar", "yaptırarım", "okunar", "evlener", "güzelleşer"} {
+		if as := an.Analyze(w); len(as) != 0 {
+			t.Errorf("Analyze(%s) = %v, expected no analyses", w, as)
+		}
+	}
+}
+
+/* the passive is -(I)l after a consonant other than l and -(I)n after a vowel or l */
+func TestPassive(t *testing.T) {
+	an := load(t)
+	valid := []string{"yapıldı", "görüldü", "okundu", "başlandı", "alındı", "bilindi", "yaptırıldı"}
+	valid_out := []string{
+		"yap[VERB]+VC.PASS+TAM.PPFV.KNWN+VB.3sg",
+		"gör[VERB]+VC.PASS+TAM.PPFV.KNWN+VB.3sg",
+		"oku[VERB]+VC.PASS+TAM.PPFV.KNWN+VB.3sg",
+		"başla[VERB]+VC.PASS+TAM.PPFV.KNWN+VB.3sg",
+		"al[VERB]+VC.PASS+TAM.PPFV.KNWN+VB.3sg",
+		"bil[VERB]+VC.PASS+TAM.PPFV.KNWN+VB.3sg",
+		"yap[VERB]+VC.CAUS.2+VC.PASS+TAM.PPFV.KNWN+VB.3sg",
+	}
+	for i, w := range valid {
+		if as := an.Analyze(w); !found(as, valid_out[i]) {
+			t.Errorf("Analyze(%s) = %v, expected to contain %s", w, as, valid_out[i])
+		}
+	}
+	for _, w := range []string{"başlal", "başlaldı", "alıldı", "bilildi", "söylelmiş"} {
+		if as := an.Analyze(w); len(as) != 0 {
+			t.Errorf("Analyze(%s) = %v, expected no analyses", w, as)
+		}
+	}
+	if as := an.Analyze("okul"); found(as, "oku[VERB]+VC.PASS+IMP.2sg") {
+		t.Errorf("Analyze(okul) = %v, expected no passive", as)
+	}
+}
+
+/* ye and de raise their e before a buffer y, de only before a low vowel */
+func TestRaise(t *testing.T) {
+	an := load(t)
+	valid := []string{"yiyin", "yiyiniz", "yiyelim", "yiyebilir", "yiyip", "yiyor", "diyelim", "diyebilir", "deyin", "deyip", "diyor", "diye"}
+	for _, w := range valid {
+		if as := an.Analyze(w); len(as) == 0 {
+			t.Errorf("Analyze(%s) = %v, expected analyses", w, as)
+		}
+	}
+	invalid := []string{"yeyin", "yeyiniz", "yeyelim", "yeyebilir", "yıyor", "deyelim", "deyebilir", "diyin", "dıyor"}
+	for _, w := range invalid {
+		if as := an.Analyze(w); len(as) != 0 {
+			t.Errorf("Analyze(%s) = %v, expected no analyses", w, as)
+		}
+	}
+}
+
+func TestLexicalized(t *testing.T) {
+	an := load(t)
+	/* the derivation and the root of the lexicon, without and with Options.Lexicalized */
+	words := []string{"öğretmenler", "tarağı", "büyüteç"}
+	derived := []string{
+		"öğret[VERB]+N.V.MAN+PL+CASE.ABSL",
+		"tara[VERB]+N.V.AK+CASE.ACC",
+		"büyü[VERB]+VC.CAUS.1+N.V.AC+CASE.ABSL",
+	}
+	atomic := []string{
+		"öğretmen[NOUN]+PL+CASE.ABSL",
+		"tarak[NOUN]+CASE.ACC",
+		"büyüteç[NOUN]+CASE.ABSL",
+	}
+	for i, w := range words {
+		if as := an.Analyze(w); !found(as, derived[i]) || !found(as, atomic[i]) {
+			t.Errorf("Analyze(%s) = %v, expected to contain %s and %s", w, as, derived[i], atomic[i])
+		}
+	}
+
+	an.Options.Lexicalized = true
+	for i, w := range words {
+		if as := an.Analyze(w); found(as, derived[i]) || !found(as, atomic[i]) {
+			t.Errorf("Analyze(%s) = %v with Lexicalized, expected to contain %s and not %s", w, as, atomic[i], derived[i])
+		}
+	}
+	/* derivations that are not in the lexicon are still decomposed */
+	if as := an.Analyze("öğretici"); !found(as, "öğret[VERB]+N.V.ICI+CASE.ABSL") {
+		t.Errorf("Analyze(öğretici) = %v with Lexicalized, expected to contain öğret[VERB]+N.V.ICI+CASE.ABSL", as)
+	}
+}
+
+func TestAnalyzeNormalized(t *testing.T) {
+	an := load(t)
+	valid := []string{"İstanbul", "EVLERİMİZDEN", "IŞIKLAR", "c\u0327ocuk", "Gidiyorum"}
+	valid_out := []string{
+		"istanbul[NOUN]+CASE.ABSL",
+		"ev[NOUN]+PL+POS.1pl+CASE.ABL",
+		"ışık[NOUN]+PL+CASE.ABSL",
+		"çocuk[NOUN]+CASE.ABSL",
+		"git[VERB]+TAM.PRS.IPFV+PRED.1sg",
+	}
+	lower := []string{"istanbul", "evlerimizden", "ışıklar", "çocuk", "gidiyorum"}
+	cased := []string{"İstanbul", "EVLERİMİZDEN", "IŞIKLAR", "çocuk", "Gidiyorum"}
+	for i, w := range valid {
+		as := an.Analyze(w)
+		if !found(as, valid_out[i]) {
+			t.Errorf("Analyze(%q) = %v, expected to contain %s", w, as, valid_out[i])
+		}
+		for _, a := range as {
+			if string(a.Word) != lower[i] {
+				t.Errorf("Analyze(%q) = %v with word %s, expected %s", w, a, a.Word, lower[i])
+			}
+		}
+	}
+
+	an.Options.PreserveCase = true
+	for i, w := range valid {
+		for _, a := range an.Analyze(w) {
+			if string(a.Word) != cased[i] {
+				t.Errorf("Analyze(%q) = %v with PreserveCase and word %s, expected %s", w, a, a.Word, cased[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package analysis
+
+import (
+	"strings"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/* the tag of the negative aorist -z, which only follows NEG.NEG and NEG.INAB */
+const AorNeg = "TAM.AOR.NEG"
+
+/* the 1sg suffix after the negative aorist, which replaces its -z */
+var aorNeg1sg = inf.NewAllomorphs(inf.Suffix{Body: []rune("m")})
+
+/*
+Returns the stem and allomorphs to append for the irregular persons of the negative aorist, whose -z is
+dropped in the 1sg and 1pl: yapmam, yapamam, yapmayız, yapamayız (not yapmazım, yapmazız). Returns false
+for any other suffix, which appends as usual: yapmazsın, yapmazdım, yapamazsınız.
+*/
+func (an *Analyzer) negativeAorist(a Analysis, tag string) (inf.Stem, *inf.Allomorphs, bool) {
+	if len(a.Tags) == 0 || a.Tags[len(a.Tags)-1] != AorNeg {
+		return nil, nil, false
+	}
+	stem := a.Stems[len(a.Stems)-1]
+	stem = stem[:len(stem)-1]
+	switch tag {
+	case "PRED.1sg":
+		return stem, aorNeg1sg, true
+	case "PRED.1pl":
+		allo, ok := an.Catalog.Allomorphs(tag)
+		return stem, allo, ok
+	}
+	return nil, nil, false
+}
+
+/* the tag of the negative aorist participle, which is also a predicate: yapmazsın, yapmazsınız */
+const ptcpAorNeg = "PTCP.IMPRS.AOR.NEG"
+
+/* the lexicon flag of the monosyllabic verbs whose aorist is -Ir (gelir, alır) rather than -Ar (yapar) */
+const HighAorist = "HIGHAOR"
+
+/* the last keys of the tags of the positive aorist and its participle: TAM.AOR.A, PTCP.IMPRS.AOR.I, ... */
+var aoristVowels = map[string]string{
+	"TAM.AOR.A": "A", "TAM.AOR.I": "I", "PTCP.IMPRS.AOR.A": "A", "PTCP.IMPRS.AOR.I": "I",
+}
+
+/*
+Returns the tag of the positive aorist of a verb: TAM.AOR.I (-Ir) for a verb of several syllables (okur,
+çalışır) or a monosyllabic verb flagged HighAorist (gelir, alır), and TAM.AOR.A (-Ar) for the other
+monosyllabic verbs (yapar, gider)
+*/
+func Aorist(e Entry) string {
+	vowels := 0
+	for _, r := range e.Root {
+		if inf.Vowel[r] {
+			vowels++
+		}
+	}
+	if vowels > 1 || contains(e.Flags, HighAorist) {
+		return "TAM.AOR.I"
+	}
+	return "TAM.AOR.A"
+}
+
+/*
+Reports whether the suffix with the tag is a 1sg or 1pl predicate of the negative aorist participle, which
+would keep the -z that the negative aorist drops in these persons: not yapmazım, yapmazız
+*/
+func aoristParticiplePerson(a Analysis, tag string) bool {
+	n := len(a.Tags)
+	return (tag == "PRED.1sg" || tag == "PRED.1pl") && n >= 2 && a.Tags[n-2] == ptcpAorNeg && a.Tags[n-1] == "CASE.ABSL"
+}
+
+/*
+Reports whether the last suffix of the analysis forms a new verb stem by a voice or a verbalizing suffix,
+which has more than one syllable or is derived like one, so its aorist is -Ir: yaptırır, okunur, evlenir,
+güzelleşir, and the monosyllabic yenir, denir
+*/
+func derivedVerb(a Analysis) bool {
+	n := len(a.Tags)
+	return n > 0 && (strings.HasPrefix(a.Tags[n-1], "VC.") || strings.HasPrefix(a.Tags[n-1], "V.N."))
+}
+
+/*
+Reports whether the morphotactics allow the suffix with the tag after the analysis but the aorist does not:
+the aorist of a verb stem with the other vowel than its own (geler, yapır, see Aorist, and yaptırar, okunar
+after a voice or verbalizing suffix, see derivedVerb) or a 1sg or 1pl predicate of the negative aorist participle
+*/
+func blocked(a Analysis, tag string) bool {
+	if v, ok := aoristVowels[tag]; ok {
+		switch {
+		case derivedVerb(a):
+			return v != "I"
+		case len(a.Tags) == 0 && a.POS == "VERB":
+			return v != aoristVowels[Aorist(Entry{Root: a.Root, POS: a.POS, Flags: a.Flags})]
+		}
+	}
+	return aoristParticiplePerson(a, tag)
+}
@@ -0,0 +1,127 @@
+package analysis
+
+import (
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/* the number of elements of the blocks of an Arena, larger requests are allocated on their own */
+const (
+	runeBlock = 1 << 16
+	listBlock = 1 << 12
+)
+
+/*
+An Arena allocates the stems, words, tags and stem lists of analyses from large blocks that are reused
+after Reset, so that analyzing a large corpus produces little garbage (see AnalyzeIn). The analyses made
+with an arena are only valid until it is Reset, typically after each document. An Arena must not be used
+by several goroutines at once. A nil *Arena allocates everything on the heap.
+*/
+type Arena struct {
+	runes runeBlocks
+	tags  tagBlocks
+	stems stemBlocks
+}
+
+/* reuses all blocks of the arena, invalidating the analyses made with it */
+func (ar *Arena) Reset() {
+	ar.runes.cursor = cursor{}
+	ar.tags.cursor = cursor{}
+	ar.stems.cursor = cursor{}
+}
+
+/* the position in the blocks of an arena: block cur is being filled and has used elements in use */
+type cursor struct {
+	cur, used int
+}
+
+/*
+reserves n elements in blocks of size elements, of which there are nblocks, moving to the next block
+if the current one is full. add reports whether the next block must be allocated, and ok is false
+(reserving nothing) if n is more than a block.
+*/
+func (c *cursor) reserve(nblocks, size, n int) (add, ok bool) {
+	if n > size {
+		return false, false
+	}
+	if c.used+n > size {
+		c.cur, c.used = c.cur+1, 0
+	}
+	c.used += n
+	return c.cur == nblocks, true
+}
+
+type runeBlocks struct {
+	blocks [][]rune
+	cursor
+}
+
+type tagBlocks struct {
+	blocks [][]string
+	cursor
+}
+
+type stemBlocks struct {
+	blocks [][]inf.Stem
+	cursor
+}
+
+/* returns a slice of n runes with capacity n, so that appending to it does not overwrite the arena */
+func (ar *Arena) runeSlice(n int) []rune {
+	if ar == nil {
+		return make([]rune, n)
+	}
+	b := &ar.runes
+	add, ok := b.reserve(len(b.blocks), runeBlock, n)
+	if !ok {
+		return make([]rune, n)
+	}
+	if add {
+		b.blocks = append(b.blocks, make([]rune, runeBlock))
+	}
+	return b.blocks[b.cur][b.used-n : b.used : b.used]
+}
+
+func (ar *Arena) tagSlice(n int) []string {
+	if ar == nil {
+		return make([]string, n)
+	}
+	b := &ar.tags
+	add, ok := b.reserve(len(b.blocks), listBlock, n)
+	if !ok {
+		return make([]string, n)
+	}
+	if add {
+		b.blocks = append(b.blocks, make([]string, listBlock))
+	}
+	return b.blocks[b.cur][b.used-n : b.used : b.used]
+}
+
+func (ar *Arena) stemSlice(n int) []inf.Stem {
+	if ar == nil {
+		return make([]inf.Stem, n)
+	}
+	b := &ar.stems
+	add, ok := b.reserve(len(b.blocks), listBlock, n)
+	if !ok {
+		return make([]inf.Stem, n)
+	}
+	if add {
+		b.blocks = append(b.blocks, make([]inf.Stem, listBlock))
+	}
+	return b.blocks[b.cur][b.used-n : b.used : b.used]
+}
+
+/* returns the stem followed by the suffix of the allomorphs, as Stem.Append, with the new stem in the arena */
+func (ar *Arena) append(stem inf.Stem, a *inf.Allomorphs) inf.Stem {
+	if ar == nil {
+		return a.Append(stem)
+	}
+	s := inf.Stem(ar.runeSlice(len(stem) + len(a.Suffix().Body) + 2))
+	copy(s, stem)
+	return a.AppendInPlace(s[:len(stem)])
+}
+
+/* returns the word of the stem, as Stem.Word, in the arena */
+func (ar *Arena) word(stem inf.Stem) inf.Word {
+	return stem.AppendWord(ar.runeSlice(len(stem))[:0])
+}
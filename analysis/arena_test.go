@@ -0,0 +1,95 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+)
+
+/* the words of the golden file, as a corpus */
+func goldenWords(t testing.TB) []string {
+	gs, err := LoadGolden("testdata/golden.txt")
+	if err != nil {
+		t.Fatalf("LoadGolden() error: %v", err)
+	}
+	var words []string
+	for _, g := range gs {
+		words = append(words, g.Word)
+	}
+	return words
+}
+
+func TestAnalyzeIn(t *testing.T) {
+	an := load(t)
+	ar := &Arena{}
+	words := append(goldenWords(t), "bana", "xyz", "")
+	for n := 0; n < 2; n++ {
+		var got [][]Analysis
+		for i, w := range words {
+			got = append(got, an.AnalyzeIn(ar, w))
+			if i%100 == 99 || i == len(words)-1 {
+				/* check a document of analyses before they are invalidated */
+				for j, as := range got {
+					w := words[i-len(got)+1+j]
+					if expected := an.Analyze(w); !reflect.DeepEqual(as, expected) {
+						t.Fatalf("AnalyzeIn(%s) = %v, expected %v", w, as, expected)
+					}
+				}
+				got = nil
+				ar.Reset()
+			}
+		}
+	}
+}
+
+func TestArena(t *testing.T) {
+	ar := &Arena{}
+	a := ar.runeSlice(3)
+	b := ar.runeSlice(runeBlock + 1) /* too large for a block */
+	c := ar.runeSlice(runeBlock - 3)
+	d := ar.runeSlice(1) /* in a new block */
+	if len(a) != 3 || cap(a) != 3 || len(b) != runeBlock+1 || len(c) != runeBlock-3 || len(d) != 1 {
+		t.Errorf("runeSlice() lengths %d %d %d %d", len(a), len(b), len(c), len(d))
+	}
+	if len(ar.runes.blocks) != 2 {
+		t.Errorf("runeSlice() used %d blocks, expected 2", len(ar.runes.blocks))
+	}
+	a = append(a, 'x')
+	c[0] = 'y'
+	if c[0] != 'y' || a[3] != 'x' {
+		t.Errorf("appending to a slice of the arena overwrote the next slice")
+	}
+	ar.Reset()
+	if e := ar.runeSlice(1); &e[0] != &ar.runes.blocks[0][0] {
+		t.Errorf("runeSlice() after Reset does not reuse the first block")
+	}
+
+	var nilArena *Arena
+	if s := nilArena.tagSlice(2); len(s) != 2 {
+		t.Errorf("tagSlice() of a nil arena = %v", s)
+	}
+}
+
+func BenchmarkAnalyze(b *testing.B) {
+	an := load(b)
+	words := goldenWords(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		an.Analyze(words[n%len(words)])
+	}
+}
+
+/* analyzes the golden words with an arena that is Reset after every document of 100 words */
+func BenchmarkAnalyzeIn(b *testing.B) {
+	an := load(b)
+	words := goldenWords(b)
+	ar := &Arena{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if n%100 == 0 {
+			ar.Reset()
+		}
+		an.AnalyzeIn(ar, words[n%len(words)])
+	}
+}
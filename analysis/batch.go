@@ -0,0 +1,19 @@
+package analysis
+
+import (
+	"github.com/kaan9/turkish-morphology/corpus"
+)
+
+/*
+Analyzes the words on workers goroutines (runtime.GOMAXPROCS if workers <= 0) and returns their analyses
+in the order of the words, as Analyze would. An Analyzer may be used concurrently as long as it is not changed.
+*/
+func (an *Analyzer) AnalyzeBatch(words []string, workers int) [][]Analysis {
+	res := make([][]Analysis, len(words))
+	corpus.Each(len(words), workers, func() func(int) {
+		return func(i int) {
+			res[i] = an.Analyze(words[i])
+		}
+	})
+	return res
+}
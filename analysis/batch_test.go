@@ -0,0 +1,19 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzeBatch(t *testing.T) {
+	an := load(t)
+	words := []string{"evlerimizden", "gidiyorum", "xyz", "kitabı", "İstanbul'da", "", "geldiniz", "yüzü"}
+	for _, workers := range []int{0, 1, 3} {
+		res := an.AnalyzeBatch(words, workers)
+		for i, w := range words {
+			if !reflect.DeepEqual(res[i], an.Analyze(w)) {
+				t.Errorf("AnalyzeBatch(%d)[%d] = %v, expected Analyze(%s) = %v", workers, i, res[i], w, an.Analyze(w))
+			}
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package analysis
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/*
+A Catalog maps suffix tags to suffixes. Tags are the dotted key paths of suffixes.toml,
+e.g. PL, CASE.DAT or TAM.PPFV.KNWN. The allomorphs of each suffix are computed when it is added.
+*/
+type Catalog struct {
+	tags       []string /* sorted */
+	suffixes   map[string]inf.Suffix
+	allomorphs map[string]*inf.Allomorphs
+}
+
+/* reads a suffix catalog in the format of suffixes.toml from the file at path */
+func LoadCatalog(path string) (*Catalog, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return DecodeCatalog(f)
+}
+
+/*
+Reads a suffix catalog from toml. Every string value is a suffix parseable by inflection.ParseSuffix
+and its tag is the dotted path of keys leading to it. Tables only group suffixes.
+*/
+func DecodeCatalog(r io.Reader) (*Catalog, error) {
+	var v map[string]interface{}
+	if _, err := toml.DecodeReader(r, &v); err != nil {
+		return nil, err
+	}
+	c := &Catalog{suffixes: map[string]inf.Suffix{}, allomorphs: map[string]*inf.Allomorphs{}}
+	if err := c.add("", v); err != nil {
+		return nil, err
+	}
+	sort.Strings(c.tags)
+	return c, nil
+}
+
+func (c *Catalog) add(prefix string, v map[string]interface{}) error {
+	for k, val := range v {
+		tag := k
+		if prefix != "" {
+			tag = prefix + "." + k
+		}
+		switch val := val.(type) {
+		case string:
+			suf, ok := inf.ParseSuffix(val)
+			if !ok {
+				return fmt.Errorf("catalog: %s: invalid suffix %q", tag, val)
+			}
+			c.tags = append(c.tags, tag)
+			c.suffixes[tag] = suf
+			c.allomorphs[tag] = inf.NewAllomorphs(suf)
+		case map[string]interface{}:
+			if err := c.add(tag, val); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("catalog: %s: expected a suffix string or a table", tag)
+		}
+	}
+	return nil
+}
+
+/* returns the suffix with the given tag */
+func (c *Catalog) Suffix(tag string) (suf inf.Suffix, ok bool) {
+	suf, ok = c.suffixes[tag]
+	return suf, ok
+}
+
+/* returns the allomorphs of the suffix with the given tag */
+func (c *Catalog) Allomorphs(tag string) (a *inf.Allomorphs, ok bool) {
+	a, ok = c.allomorphs[tag]
+	return a, ok
+}
+
+/* returns all tags of the catalog in sorted order */
+func (c *Catalog) Tags() []string {
+	return append([]string(nil), c.tags...)
+}
+
+/*
+Returns the tags that equal prefix or are subtypes of it, in sorted order.
+E.g. CASE matches CASE.ACC, CASE.DAT, ... and TAM.AOR matches TAM.AOR.A, TAM.AOR.I, TAM.AOR.NEG
+*/
+func (c *Catalog) Match(prefix string) []string {
+	var tags []string
+	for _, tag := range c.tags {
+		if tag == prefix || strings.HasPrefix(tag, prefix+".") {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+/* the parts of speech named by the first two keys of derivational tags, N is a noun or adjective */
+var categories = map[string]string{"N": "NOUN", "V": "VERB", "ADJ": "ADJ", "ADV": "ADV"}
+
+/*
+Returns the parts of speech a derivational suffix produces and attaches to, which are named by the first
+two keys of its tag: N.V.MAN (öğretmen) makes a NOUN from a VERB. ok is false for inflectional suffixes.
+*/
+func Derivation(tag string) (result, host string, ok bool) {
+	keys := strings.SplitN(tag, ".", 3)
+	if len(keys) < 3 {
+		return "", "", false
+	}
+	result, rok := categories[keys[0]]
+	host, hok := categories[keys[1]]
+	if !rok || !hok {
+		return "", "", false
+	}
+	return result, host, true
+}
+
+/* the kinds of suffixes of a catalog */
+type Category int
+
+const (
+	Inflectional Category = iota /* suffixes that keep the part of speech of a word: PL, CASE.DAT, TAM.FUT */
+	Derivational                 /* suffixes that make a word of a part of speech (see Derivation): N.V.MAN */
+)
+
+/* returns the tags of the suffixes of a category in sorted order */
+func (c *Catalog) ByCategory(cat Category) []string {
+	var tags []string
+	for _, tag := range c.tags {
+		if _, _, ok := Derivation(tag); ok == (cat == Derivational) {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+/*
+Returns the tags of the suffixes that the morphotactics allow in a word of a part of speech before any
+derivation, in sorted order: its inflections, including those of the participles and converbs of a verb, and
+the derivations attaching to it. Given prefixes (see Catalog.Match), only the tags matching one of them are
+returned, e.g. the tenses of a verb with ForPOS("VERB", "TAM") or the cases of a noun with ForPOS("NOUN", "CASE").
+*/
+func (an *Analyzer) ForPOS(pos string, prefixes ...string) []string {
+	seen := map[string]bool{}
+	var walk func(state string)
+	walk = func(state string) {
+		for _, tag := range an.Tactics.Next(state) {
+			if seen[tag] {
+				continue
+			}
+			seen[tag] = true
+			if _, _, ok := Derivation(tag); !ok {
+				walk(tag)
+			}
+		}
+	}
+	walk(pos + ".ROOT")
+
+	var tags []string
+	for _, tag := range an.Catalog.tags {
+		if !seen[tag] {
+			continue
+		}
+		for _, p := range prefixes {
+			if tag == p || strings.HasPrefix(tag, p+".") {
+				tags = append(tags, tag)
+				break
+			}
+		}
+		if len(prefixes) == 0 {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,180 @@
+package analysis
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+func TestDecodeCatalog(t *testing.T) {
+	c, err := DecodeCatalog(strings.NewReader(`
+PL = "lAr"
+[CASE]
+  ABSL = ""
+  DAT  = "(y)A"
+[TAM]
+  FUT = "(y)AcAK"
+  [TAM.AOR]
+    A = "(A)r"
+    I = "(I)r"
+`))
+	if err != nil {
+		t.Fatalf("DecodeCatalog() error: %v", err)
+	}
+
+	tags := []string{"PL", "CASE.ABSL", "CASE.DAT", "TAM.FUT", "TAM.AOR.A", "TAM.AOR.I"}
+	sufs := []inf.Suffix{
+		inf.Suffix{Head: 0, Tail: 0, Body: []rune("lAr")},
+		inf.Suffix{Head: 0, Tail: 0, Body: []rune{}},
+		inf.Suffix{Head: 'y', Tail: 0, Body: []rune("A")},
+		inf.Suffix{Head: 'y', Tail: 0, Body: []rune("AcAK")},
+		inf.Suffix{Head: 'A', Tail: 0, Body: []rune("r")},
+		inf.Suffix{Head: 'I', Tail: 0, Body: []rune("r")},
+	}
+	for i, tag := range tags {
+		suf, ok := c.Suffix(tag)
+		if !ok || !reflect.DeepEqual(suf, sufs[i]) {
+			t.Errorf("Suffix(%s) = (%#v, %v), expected (%#v, %v)", tag, suf, ok, sufs[i], true)
+		}
+	}
+	if suf, ok := c.Suffix("TAM"); ok {
+		t.Errorf("Suffix(TAM) = (%#v, %v), expected a missing suffix", suf, ok)
+	}
+
+	prefixes := []string{"TAM", "TAM.AOR", "CASE.DAT", "PL", "CAS", "VB"}
+	matches := [][]string{
+		[]string{"TAM.AOR.A", "TAM.AOR.I", "TAM.FUT"},
+		[]string{"TAM.AOR.A", "TAM.AOR.I"},
+		[]string{"CASE.DAT"},
+		[]string{"PL"},
+		nil,
+		nil,
+	}
+	for i, p := range prefixes {
+		if m := c.Match(p); !reflect.DeepEqual(m, matches[i]) {
+			t.Errorf("Match(%s) = %v, expected %v", p, m, matches[i])
+		}
+	}
+
+	invalid := []string{
+		`PL = "lar(n"`,
+		`PL = 3`,
+		`[CASE]
+  DAT = "(y)(A)"`,
+	}
+	for _, s := range invalid {
+		if _, err := DecodeCatalog(strings.NewReader(s)); err == nil {
+			t.Errorf("DecodeCatalog(%s) succeeded, expected an error", s)
+		}
+	}
+}
+
+func TestLoadCatalog(t *testing.T) {
+	c, err := LoadCatalog("../" + CatalogFile)
+	if err != nil {
+		t.Fatalf("LoadCatalog() error: %v", err)
+	}
+	for _, tag := range []string{"PL", "CASE.LOC", "TAM.PRS.PROG", "VC.CAUS.2", "CVB.T.1", "N.N.LIK"} {
+		if _, ok := c.Suffix(tag); !ok {
+			t.Errorf("Suffix(%s) missing from %s", tag, CatalogFile)
+		}
+	}
+}
+
+func TestDerivation(t *testing.T) {
+	valid := []string{"N.V.MAN", "V.N.LA", "N.N.LI", "ADV.ADV.RAK"}
+	valid_out := [][2]string{{"NOUN", "VERB"}, {"VERB", "NOUN"}, {"NOUN", "NOUN"}, {"ADV", "ADV"}}
+	for i, tag := range valid {
+		result, host, ok := Derivation(tag)
+		if !ok || result != valid_out[i][0] || host != valid_out[i][1] {
+			t.Errorf("Derivation(%s) = (%s, %s, %v), expected (%s, %s, true)", tag, result, host, ok, valid_out[i][0], valid_out[i][1])
+		}
+	}
+
+	invalid := []string{"PL", "N.N", "CASE.DAT", "NUM.ORD", "TAM.PPFV.KNWN"}
+	for _, tag := range invalid {
+		if result, host, ok := Derivation(tag); ok {
+			t.Errorf("Derivation(%s) = (%s, %s, true), expected not derivational", tag, result, host)
+		}
+	}
+}
+
+/* appends every suffix of the catalog to every root of the lexicon and to every root followed by a suffix */
+func TestCatalogAllomorphs(t *testing.T) {
+	an := load(t)
+	tags := an.Catalog.Tags()
+	var stems []inf.Stem
+	for _, e := range an.Lexicon.Entries {
+		stems = append(stems, inf.Stem(e.Root))
+		for _, tag := range tags {
+			suf, _ := an.Catalog.Suffix(tag)
+			stems = append(stems, inf.Stem(e.Root).Append(suf))
+		}
+	}
+	for _, tag := range tags {
+		suf, _ := an.Catalog.Suffix(tag)
+		a, ok := an.Catalog.Allomorphs(tag)
+		if !ok {
+			t.Fatalf("Allomorphs(%s) missing", tag)
+		}
+		for _, stem := range stems {
+			if out, expected := a.Append(stem), stem.Append(suf); !reflect.DeepEqual(out, expected) {
+				t.Errorf("Allomorphs(%s).Append(%v) = %v, expected %v", tag, stem, out, expected)
+			}
+		}
+	}
+}
+
+func TestByCategory(t *testing.T) {
+	c, err := DecodeCatalog(strings.NewReader(`
+PL = "lAr"
+[CASE]
+  DAT = "(y)A"
+[N.V]
+  MAN = "mAn"
+[V.N]
+  LA = "lA"
+`))
+	if err != nil {
+		t.Fatalf("DecodeCatalog() error: %v", err)
+	}
+	cats := []Category{Inflectional, Derivational}
+	tags := [][]string{
+		[]string{"CASE.DAT", "PL"},
+		[]string{"N.V.MAN", "V.N.LA"},
+	}
+	for i, cat := range cats {
+		if out := c.ByCategory(cat); !reflect.DeepEqual(out, tags[i]) {
+			t.Errorf("ByCategory(%v) = %v, expected %v", cat, out, tags[i])
+		}
+	}
+}
+
+func TestForPOS(t *testing.T) {
+	an := load(t)
+	valid := [][]string{{"NOUN", "CASE"}, {"VERB", "NEG"}, {"NOUN", "V"}, {"ADJ", "N.ADJ"}, {"ADV"}}
+	valid_out := [][]string{
+		[]string{"CASE.ABL", "CASE.ABSL", "CASE.ACC", "CASE.DAT", "CASE.GEN", "CASE.INS", "CASE.LOC"},
+		[]string{"NEG.INAB", "NEG.NEG"},
+		[]string{"V.N.AL", "V.N.LA", "V.N.LAN", "V.N.LAS", "V.N.SA"},
+		nil, /* only adjectives of taste and color take -(I)mtrak */
+		[]string{"ADV.ADV.RAK"},
+	}
+	for i, args := range valid {
+		if out := an.ForPOS(args[0], args[1:]...); !reflect.DeepEqual(out, valid_out[i]) {
+			t.Errorf("ForPOS(%v) = %v, expected %v", args, out, valid_out[i])
+		}
+	}
+
+	/* a noun takes no tense before a derivation, and the suffixes after a derivation are not included */
+	for _, tag := range an.ForPOS("NOUN") {
+		if strings.HasPrefix(tag, "TAM.") || strings.HasPrefix(tag, "N.V.") {
+			t.Errorf("ForPOS(NOUN) includes %s", tag)
+		}
+	}
+	if out := an.ForPOS("XYZ"); out != nil {
+		t.Errorf("ForPOS(XYZ) = %v, expected nil", out)
+	}
+}
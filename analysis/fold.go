@@ -0,0 +1,50 @@
+package analysis
+
+import (
+	inf "github.com/kaan9/turkish-morphology/inflection"
+	"github.com/kaan9/turkish-morphology/normalize"
+)
+
+/* matches the words whose characters fold to the characters of w, which is folded */
+type folded struct {
+	w    []rune
+	fold func(rune) rune
+}
+
+func (m folded) prefix(stem inf.Stem) bool {
+	return len(stem)-1 <= len(m.w) && m.agrees([]rune(stem[:len(stem)-1]))
+}
+
+func (m folded) word(word inf.Word) bool {
+	return len(word) == len(m.w) && m.agrees([]rune(word))
+}
+
+func (m folded) agrees(s []rune) bool {
+	for i, c := range s {
+		if m.fold(c) != m.w[i] {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+Returns the analyses of all words that fold to the same characters as a word (normalized as by Analyze),
+where fold maps each character to the character it is folded to. With a fold that drops diacritics
+(ç -> c, ı -> i, ...) the analyses of calisiyorum are those of çalışıyorum. Analyses are ordered by the
+order of the lexicon and the morphotactics.
+*/
+func (an *Analyzer) AnalyzeFolded(word string, fold func(rune) rune) []Analysis {
+	w := []rune(normalize.Lower(word))
+	for i, c := range w {
+		w[i] = fold(c)
+	}
+	m := folded{w, fold}
+	var res []Analysis
+	for _, e := range an.Lexicon.Entries {
+		if m.prefix(inf.Stem(e.Root)) {
+			an.searchFrom(m, e, nil, &res)
+		}
+	}
+	return res
+}
@@ -0,0 +1,39 @@
+package analysis
+
+import (
+	"testing"
+)
+
+func TestAnalyzeFolded(t *testing.T) {
+	an := load(t)
+	/* folds the dotless ı and ş, as typed on a keyboard without them */
+	fold := func(r rune) rune {
+		switch r {
+		case 'ı':
+			return 'i'
+		case 'ş':
+			return 's'
+		}
+		return r
+	}
+	valid := []string{"çalisiyorum", "kis", "ev", "kisin"}
+	valid_out := []string{
+		"çalış[VERB]+TAM.PRS.IPFV+PRED.1sg",
+		"kış[NOUN]+CASE.ABSL",
+		"ev[NOUN]+CASE.ABSL",
+		"kış[NOUN]+POS.2sg+CASE.ABSL",
+	}
+	for i, w := range valid {
+		if as := an.AnalyzeFolded(w, fold); !found(as, valid_out[i]) {
+			t.Errorf("AnalyzeFolded(%s) = %v, expected to contain %s", w, as, valid_out[i])
+		}
+	}
+
+	/* ç is not folded, so çalışıyorum cannot be written without it */
+	invalid := []string{"calısıyorum", "xyz", ""}
+	for _, w := range invalid {
+		if as := an.AnalyzeFolded(w, fold); len(as) != 0 {
+			t.Errorf("AnalyzeFolded(%s) = %v, expected no analyses", w, as)
+		}
+	}
+}
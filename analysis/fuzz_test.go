@@ -0,0 +1,42 @@
+//go:build go1.18
+// +build go1.18
+
+/* the fuzz targets need testing.F, which is newer than the go version of the module */
+
+package analysis
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func FuzzRoundTrip(f *testing.F) {
+	an, err := Load("..")
+	if err != nil {
+		f.Fatalf("Load() error: %v", err)
+	}
+	for _, seed := range []int64{0, 1, 2, 42} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		roundTrip(t, an, rand.New(rand.NewSource(seed)))
+	})
+}
+
+/* every analysis of any string generates the analyzed word */
+func FuzzAnalyze(f *testing.F) {
+	an, err := Load("..")
+	if err != nil {
+		f.Fatalf("Load() error: %v", err)
+	}
+	for _, w := range []string{"evlerimizden", "gidiyorum", "İstanbul", "kitabı", ""} {
+		f.Add(w)
+	}
+	f.Fuzz(func(t *testing.T, w string) {
+		for _, a := range an.Analyze(w) {
+			if b, ok := an.Generate(Entry{Root: a.Root, POS: a.POS, Flags: a.Flags}, a.Tags); !ok || b.Word.String() != a.Word.String() {
+				t.Errorf("Analyze(%q) = %v, which generates (%s, %v)", w, a, b.Word, ok)
+			}
+		}
+	})
+}
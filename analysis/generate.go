@@ -0,0 +1,115 @@
+package analysis
+
+import (
+	"math/rand"
+	"strings"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/*
+Generates the word of a lexicon entry followed by the suffixes with the given tags, the inverse of Analyze.
+Returns false if the morphotactics do not allow the tags in this order or do not allow the word to end after them.
+*/
+func (an *Analyzer) Generate(e Entry, tags []string) (Analysis, bool) {
+	a := Analysis{Root: e.Root, POS: e.POS, Flags: e.Flags, Stems: []inf.Stem{inf.Stem(e.Root)}}
+	state := an.Tactics.Root(e)
+	for _, tag := range tags {
+		if !contains(an.Tactics.Next(state), tag) || blocked(a, tag) {
+			return Analysis{}, false
+		}
+		a.Stems = append(a.Stems, an.append(nil, a, tag))
+		a.Tags = append(a.Tags, tag)
+		state = tag
+	}
+	if !an.Tactics.Final(state) {
+		return Analysis{}, false
+	}
+	a.Word = a.Stems[len(a.Stems)-1].Word()
+	return a, true
+}
+
+/*
+Parses an analysis in the format of Analysis.String, lemma[POS]+TAG+TAG..., and generates its word
+from the lexicon entry with that lemma and part of speech. Returns false if there is no such entry or
+the tags cannot be generated (see Generate).
+*/
+func (an *Analyzer) ParseAnalysis(s string) (Analysis, bool) {
+	i, j := strings.IndexByte(s, '['), strings.IndexByte(s, ']')
+	if i <= 0 || j < i {
+		return Analysis{}, false
+	}
+	lemma, pos := s[:i], s[i+1:j]
+	var tags []string
+	if rest := s[j+1:]; rest != "" {
+		if !strings.HasPrefix(rest, "+") {
+			return Analysis{}, false
+		}
+		tags = strings.Split(rest[1:], "+")
+	}
+	for _, e := range an.Entries(lemma, pos) {
+		if a, ok := an.Generate(e, tags); ok {
+			return a, true
+		}
+	}
+	return Analysis{}, false
+}
+
+/* returns the lexicon entries with a lemma (the resolved root, see Analysis.Lemma) and part of speech */
+func (an *Analyzer) Entries(lemma, pos string) []Entry {
+	var es []Entry
+	for _, e := range an.Lexicon.Entries {
+		if e.POS == pos && inf.Stem(e.Root).Word().String() == lemma {
+			es = append(es, e)
+		}
+	}
+	return es
+}
+
+/*
+Generates a random word by a random walk of the morphotactics from a random lexicon entry, with at most max
+suffixes. Like Analyze, the walk never adds an empty suffix twice while the stem has not grown. Returns false
+if the walk did not reach a state where the word may end.
+*/
+func (an *Analyzer) Random(r *rand.Rand, max int) (Analysis, bool) {
+	if len(an.Lexicon.Entries) == 0 {
+		return Analysis{}, false
+	}
+	e := an.Lexicon.Entries[r.Intn(len(an.Lexicon.Entries))]
+	a := Analysis{Root: e.Root, POS: e.POS, Flags: e.Flags, Stems: []inf.Stem{inf.Stem(e.Root)}}
+	state, empty := an.Tactics.Root(e), []string(nil)
+	for {
+		stem := a.Stems[len(a.Stems)-1]
+		var next []string
+		if len(a.Tags) < max {
+			for _, tag := range an.Tactics.Next(state) {
+				if !contains(empty, tag) && !blocked(a, tag) {
+					next = append(next, tag)
+				}
+			}
+		}
+		final := an.Tactics.Final(state)
+		n := len(next)
+		if final {
+			n++
+		}
+		if n == 0 {
+			return Analysis{}, false
+		}
+		k := r.Intn(n)
+		if k == len(next) {
+			a.Word = stem.Word()
+			return a, true
+		}
+		tag := next[k]
+		s := an.append(nil, a, tag)
+		if len(s) == len(stem) {
+			empty = append(empty, tag)
+		} else {
+			empty = nil
+		}
+		a.Tags = append(a.Tags, tag)
+		a.Stems = append(a.Stems, s)
+		state = tag
+	}
+}
@@ -0,0 +1,64 @@
+package analysis
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestParseAnalysis(t *testing.T) {
+	an := load(t)
+	valid := []string{
+		"ev[NOUN]+PL+POS.1pl+CASE.ABL",
+		"kitap[NOUN]+CASE.ACC",
+		"git[VERB]+TAM.PRS.IPFV+PRED.1sg",
+		"yap[VERB]+NEG.INAB+VSX.ABIL+TAM.AOR.I+PRED.1sg",
+		"o[PRON]+CASE.DAT",
+		"ve[CONJ]",
+	}
+	valid_out := []string{"evlerimizden", "kitabı", "gidiyorum", "yapamayabilirim", "ona", "ve"}
+	for i, s := range valid {
+		a, ok := an.ParseAnalysis(s)
+		if !ok || a.Word.String() != valid_out[i] || a.String() != s || len(a.Stems) != len(a.Tags)+1 {
+			t.Errorf("ParseAnalysis(%s) = (%v, %v) with word %s, expected %s", s, a, ok, a.Word, valid_out[i])
+		}
+	}
+
+	invalid := []string{
+		"", "ev", "ev[NOUN]", "ev[VERB]+CASE.ABSL", "xyz[NOUN]+CASE.ABSL", "ev[NOUN]+CASE.ABSL+PL",
+		"ev[NOUN]+PL", "ev[NOUN]CASE.ABSL", "ev[NOUN]+CASE.XYZ",
+	}
+	for _, s := range invalid {
+		if a, ok := an.ParseAnalysis(s); ok {
+			t.Errorf("ParseAnalysis(%s) = %v, expected to fail", s, a)
+		}
+	}
+}
+
+/* every random word has the analysis it was generated from */
+func roundTrip(t *testing.T, an *Analyzer, r *rand.Rand) {
+	a, ok := an.Random(r, 8)
+	if !ok {
+		return
+	}
+	if !found(an.Analyze(a.Word.String()), a.String()) {
+		t.Errorf("Analyze(%s) = %v, expected to contain the generated %s", a.Word, an.Analyze(a.Word.String()), a)
+	}
+	if b, ok := an.Generate(Entry{Root: a.Root, POS: a.POS, Flags: a.Flags}, a.Tags); !ok || b.Word.String() != a.Word.String() {
+		t.Errorf("Generate(%s) = (%s, %v), expected %s", a, b.Word, ok, a.Word)
+	}
+}
+
+func TestRandom(t *testing.T) {
+	an := load(t)
+	r := rand.New(rand.NewSource(1))
+	n := 0
+	for i := 0; i < 2000; i++ {
+		if _, ok := an.Random(r, 8); ok {
+			n++
+		}
+		roundTrip(t, an, r)
+	}
+	if n == 0 {
+		t.Errorf("Random() never generated a word")
+	}
+}
@@ -0,0 +1,94 @@
+package analysis
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+/* A Golden form is an expected word and one of its analyses, from line Line of a golden file */
+type Golden struct {
+	Word     string
+	Analysis string
+	Line     int
+}
+
+/*
+A Diff is a golden form that the analyzer gets wrong: Generated is the word generated from the analysis
+("" if it cannot be generated) and Analyzed reports whether the analysis is among the analyses of the word
+*/
+type Diff struct {
+	Golden
+	Generated string
+	Analyzed  bool
+}
+
+func (d Diff) String() string {
+	s := fmt.Sprintf("line %d: %s %s:", d.Line, d.Word, d.Analysis)
+	if d.Generated == "" {
+		s += " cannot be generated"
+	} else if d.Generated != d.Word {
+		s += " generates " + d.Generated
+	}
+	if !d.Analyzed {
+		s += " not found by Analyze"
+	}
+	return s
+}
+
+/* reads a golden file from the file at path */
+func LoadGolden(path string) ([]Golden, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadGolden(f)
+}
+
+/*
+Reads a golden file: one expected form per line as WORD ANALYSIS, with the analysis in the format of
+Analysis.String. Blank lines and lines starting with # are skipped.
+*/
+func ReadGolden(r io.Reader) ([]Golden, error) {
+	var gs []Golden
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("golden: line %d: expected WORD ANALYSIS", n)
+		}
+		gs = append(gs, Golden{Word: fields[0], Analysis: fields[1], Line: n})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return gs, nil
+}
+
+/*
+Checks golden forms in both directions: the word generated from each analysis (see ParseAnalysis) must be
+the expected word, and the analysis must be among the analyses of the word. Returns the forms that fail.
+*/
+func (an *Analyzer) CheckGolden(gs []Golden) []Diff {
+	var diffs []Diff
+	for _, g := range gs {
+		d := Diff{Golden: g}
+		if a, ok := an.ParseAnalysis(g.Analysis); ok {
+			d.Generated = a.Word.String()
+		}
+		for _, a := range an.Analyze(g.Word) {
+			d.Analyzed = d.Analyzed || a.String() == g.Analysis
+		}
+		if d.Generated != g.Word || !d.Analyzed {
+			diffs = append(diffs, d)
+		}
+	}
+	return diffs
+}
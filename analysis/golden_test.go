@@ -0,0 +1,48 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadGolden(t *testing.T) {
+	gs, err := ReadGolden(strings.NewReader("# comment\n\nev\tev[NOUN]+CASE.ABSL\n  kitabı kitap[NOUN]+CASE.ACC\n"))
+	if err != nil || len(gs) != 2 || gs[1] != (Golden{Word: "kitabı", Analysis: "kitap[NOUN]+CASE.ACC", Line: 4}) {
+		t.Errorf("ReadGolden() = (%v, %v), expected 2 forms", gs, err)
+	}
+
+	invalid := []string{"ev", "ev ev[NOUN]+CASE.ABSL extra"}
+	for _, s := range invalid {
+		if _, err := ReadGolden(strings.NewReader(s)); err == nil {
+			t.Errorf("ReadGolden(%s) succeeded, expected an error", s)
+		}
+	}
+}
+
+func TestCheckGolden(t *testing.T) {
+	an := load(t)
+	gs := []Golden{
+		{Word: "evler", Analysis: "ev[NOUN]+PL+CASE.ABSL", Line: 1},
+		{Word: "evlar", Analysis: "ev[NOUN]+PL+CASE.ABSL", Line: 2},
+		{Word: "ev", Analysis: "ev[NOUN]+PL", Line: 3},
+	}
+	diffs := an.CheckGolden(gs)
+	if len(diffs) != 2 || diffs[0].Generated != "evler" || diffs[0].Analyzed || diffs[1].Generated != "" {
+		t.Errorf("CheckGolden(%v) = %v, expected the last two forms to differ", gs, diffs)
+	}
+	if s := diffs[0].String(); s != "line 2: evlar ev[NOUN]+PL+CASE.ABSL: generates evler not found by Analyze" {
+		t.Errorf("Diff.String() = %s", s)
+	}
+}
+
+/* the forms of the bundled golden list */
+func TestGolden(t *testing.T) {
+	an := load(t)
+	gs, err := LoadGolden("testdata/golden.txt")
+	if err != nil {
+		t.Fatalf("LoadGolden() error: %v", err)
+	}
+	for _, d := range an.CheckGolden(gs) {
+		t.Error(d)
+	}
+}
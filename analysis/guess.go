@@ -0,0 +1,64 @@
+package analysis
+
+import (
+	inf "github.com/kaan9/turkish-morphology/inflection"
+	"github.com/kaan9/turkish-morphology/normalize"
+)
+
+/* the penalty of an analysis with a guessed root */
+const GuessPenalty = 5.0
+
+/* the parts of speech of guessed roots, in order of preference */
+var guess_pos = []string{"NOUN", "VERB"}
+
+/* the voiced final consonants of a stem followed by a vowel and the root-final characters they come from */
+var devoiced = map[rune]rune{'b': 'B', 'c': 'C', 'd': 'D', 'g': 'K', 'ğ': 'K'}
+
+/*
+Returns the analyses of a word (normalized as by Analyze) whose root is hypothesized from a prefix of the
+word instead of taken from the lexicon. Roots are at least two characters long with a vowel, as nouns or
+verbs, longest first. A prefix ending in a voiced b/c/d/g/ğ is taken as a root ending in B/C/D/K whose
+final consonant was voiced by the following vowel (kebabı: kebaB) since Turkish words do not end in b/c/d/g.
+The suffixes harmonize with the guessed root as with any other root. Guessed analyses have a GuessPenalty.
+*/
+func (an *Analyzer) Guess(word string) []Analysis {
+	w := []rune(normalize.Lower(word))
+	var res []Analysis
+	for i := len(w); i >= 2; i-- {
+		for _, root := range guessRoots(w[:i]) {
+			for _, pos := range guess_pos {
+				an.analyzeFrom(Entry{Root: root, POS: pos}, w, 0, nil, &res)
+			}
+		}
+	}
+	for i := range res {
+		res[i].Guessed = true
+		res[i].Penalty += GuessPenalty
+	}
+	return res
+}
+
+/* the roots that could be written as prefix p of a word */
+func guessRoots(p []rune) []inf.Root {
+	vowel := false
+	for _, c := range p {
+		vowel = vowel || inf.Vowel[c]
+	}
+	if !vowel {
+		return nil
+	}
+	if _, ok := inf.ParseRoot(string(p)); !ok {
+		return nil
+	}
+	last := p[len(p)-1]
+	var roots []inf.Root
+	if last != 'b' && last != 'c' && last != 'd' && last != 'g' {
+		roots = append(roots, inf.Root(p))
+	}
+	if r, ok := devoiced[last]; ok && len(p) > 1 {
+		root := append(inf.Root(nil), p...)
+		root[len(root)-1] = r
+		roots = append(roots, root)
+	}
+	return roots
+}
@@ -0,0 +1,50 @@
+package analysis
+
+import (
+	"testing"
+)
+
+func TestGuess(t *testing.T) {
+	an := load(t)
+	valid := []string{"kebabı", "zoomdayım", "dolmuşlarda", "tweetliyor", "rengi", "çiğ"}
+	valid_out := []string{
+		"kebap[NOUN]+CASE.ACC",
+		"zoom[NOUN]+CASE.LOC+PRED.1sg",
+		"dolmuş[NOUN]+PL+CASE.LOC",
+		"tweet[NOUN]+V.N.LA+TAM.PRS.IPFV+PRED.3sg",
+		"renk[NOUN]+CASE.ACC",
+		"çiğ[NOUN]+CASE.ABSL",
+	}
+	for i, w := range valid {
+		as := an.Guess(w)
+		if !found(as, valid_out[i]) {
+			t.Errorf("Guess(%s) = %v, expected to contain %s", w, as, valid_out[i])
+		}
+		for _, a := range as {
+			if !a.Guessed || a.Penalty != GuessPenalty || string(a.Word) != w {
+				t.Errorf("Guess(%s) = %v with (Guessed, Penalty, Word) = (%v, %v, %s)", w, a, a.Guessed, a.Penalty, a.Word)
+			}
+		}
+	}
+
+	/* roots never end in a voiced b/c/d/g and guessed roots have a vowel */
+	invalid := []string{"kebab", "krd", "xyz"}
+	for _, w := range invalid {
+		if as := an.Guess(w); len(as) != 0 {
+			t.Errorf("Guess(%s) = %v, expected no analyses", w, as)
+		}
+	}
+
+	if as := an.Analyze("kebabı"); len(as) != 0 {
+		t.Errorf("Analyze(kebabı) = %v without GuessRoots, expected no analyses", as)
+	}
+	an.Options.GuessRoots = true
+	if as := an.Analyze("kebabı"); !found(as, "kebap[NOUN]+CASE.ACC") {
+		t.Errorf("Analyze(kebabı) = %v with GuessRoots, expected to contain kebap[NOUN]+CASE.ACC", as)
+	}
+	for _, a := range an.Analyze("kitabı") {
+		if a.Guessed {
+			t.Errorf("Analyze(kitabı) = %v, expected no guesses for a word of the lexicon", a)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package analysis
+
+import (
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/* the flag of temporal nouns (dün, sabah, o zaman), whose start state TEMPORAL.ROOT allows the relative suffix */
+const Temporal = "TEMPORAL"
+
+/* the tag of the relative suffix -ki */
+const Rel = "REL"
+
+/* -ki rounded after ü and u */
+var relRounded = inf.NewAllomorphs(inf.Suffix{Body: []rune("kü")})
+
+/* the tag of the passive -(I)l */
+const Passive = "VC.PASS"
+
+/* the passive after a vowel or l, which is -(I)n like the reflexive: okun, başlan, alın, bilin */
+var passiveN = inf.NewAllomorphs(inf.Suffix{Head: 'I', Body: []rune("n")})
+
+/*
+Returns the allomorphs of the suffix with the tag following the analysis, with the exceptions to harmony that
+depend on more than the stem. The relative suffix -ki does not harmonize (masadaki, benimki, yarınki, sabahki),
+except directly after a temporal root whose last vowel is ü or u, where it is rounded: dünkü, bugünkü, o günkü.
+The passive is -(I)l only after a consonant other than l (yapıl, görül) and -(I)n elsewhere (okun, alın).
+*/
+func (an *Analyzer) allomorphs(a Analysis, tag string) *inf.Allomorphs {
+	if tag == Rel && len(a.Tags) == 0 && contains(a.Flags, Temporal) {
+		if v := lastVowel(a.Stems[0]); v == 'ü' || v == 'u' {
+			return relRounded
+		}
+	}
+	if tag == Passive {
+		if stem := a.Stems[len(a.Stems)-1]; inf.Vowel[stem[len(stem)-1]] || stem[len(stem)-1] == 'l' {
+			return passiveN
+		}
+	}
+	allo, _ := an.Catalog.Allomorphs(tag)
+	return allo
+}
+
+/* returns the last vowel of a stem, 0 if it has none */
+func lastVowel(s inf.Stem) rune {
+	for i := len(s) - 1; i >= 0; i-- {
+		if inf.Vowel[s[i]] {
+			return s[i]
+		}
+	}
+	return 0
+}
@@ -0,0 +1,83 @@
+package analysis
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/*
+An Entry is a root of the lexicon with its part of speech (NOUN, VERB, ADJ, ...) and optional flags.
+A root with part of speech P is inflected starting from the morphotactics state P.ROOT, or from F.ROOT
+for a flag F that is a state of the morphotactics (see Morphotactics.Root)
+*/
+type Entry struct {
+	Root  inf.Root
+	POS   string
+	Flags []string
+}
+
+/* A Lexicon is the list of known roots */
+type Lexicon struct {
+	Entries []Entry
+}
+
+/* reads a lexicon in the format of lexicon.txt from the file at path */
+func LoadLexicon(path string) (*Lexicon, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return DecodeLexicon(f)
+}
+
+/*
+Reads a lexicon. Each line is of the form
+ROOT POS FLAG FLAG ...
+with the root as parsed by inflection.ParseRoot. Anything following a # is a comment.
+*/
+func DecodeLexicon(r io.Reader) (*Lexicon, error) {
+	lex := &Lexicon{}
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("lexicon: line %d: missing part of speech", n)
+		}
+		root, ok := inf.ParseRoot(fields[0])
+		if !ok {
+			return nil, fmt.Errorf("lexicon: line %d: invalid root %q", n, fields[0])
+		}
+		var flags []string
+		if len(fields) > 2 {
+			flags = fields[2:]
+		}
+		lex.Entries = append(lex.Entries, Entry{Root: root, POS: fields[1], Flags: flags})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lex, nil
+}
+
+/* reports whether the entry has the given flag */
+func (e Entry) Has(flag string) bool {
+	for _, f := range e.Flags {
+		if f == flag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package analysis
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+func TestDecodeLexicon(t *testing.T) {
+	lex, err := DecodeLexicon(strings.NewReader(`
+# comment
+ev NOUN
+kitaB   NOUN  # kitap, kitabı
+bu(n) PRON
+ankara NOUN PROPER X
+`))
+	if err != nil {
+		t.Fatalf("DecodeLexicon() error: %v", err)
+	}
+	entries := []Entry{
+		Entry{Root: inf.Root("ev"), POS: "NOUN"},
+		Entry{Root: inf.Root("kitaB"), POS: "NOUN"},
+		Entry{Root: inf.Root("buN"), POS: "PRON"},
+		Entry{Root: inf.Root("ankara"), POS: "NOUN", Flags: []string{"PROPER", "X"}},
+	}
+	if !reflect.DeepEqual(lex.Entries, entries) {
+		t.Errorf("DecodeLexicon() = %#v, expected %#v", lex.Entries, entries)
+	}
+	if !lex.Entries[3].Has("PROPER") || lex.Entries[2].Has("PROPER") {
+		t.Errorf("Has(PROPER) = (%v, %v), expected (%v, %v)",
+			lex.Entries[3].Has("PROPER"), lex.Entries[2].Has("PROPER"), true, false)
+	}
+
+	invalid := []string{"ev", "KitaB NOUN", "kitaP NOUN"}
+	for _, s := range invalid {
+		if _, err := DecodeLexicon(strings.NewReader(s)); err == nil {
+			t.Errorf("DecodeLexicon(%s) succeeded, expected an error", s)
+		}
+	}
+}
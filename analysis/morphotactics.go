@@ -0,0 +1,177 @@
+package analysis
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+/* the target marking a state after which a word may end */
+const End = "END"
+
+/*
+Morphotactics is the finite state automaton of suffix ordering described by suffix-order.txt.
+Its states are the tags of the last suffix added, root states such as NOUN.ROOT and named groups.
+Each state lists the tags of the suffixes that may follow it, in order of preference.
+*/
+type Morphotactics struct {
+	next  map[string][]string
+	final map[string]bool
+}
+
+/* a block of the morphotactics file: one or more header states sharing their targets */
+type block struct {
+	states  []string
+	targets []string
+}
+
+/* reads the morphotactics from the file at path, checking its tags against the catalog */
+func LoadMorphotactics(path string, c *Catalog) (*Morphotactics, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return DecodeMorphotactics(f, c)
+}
+
+/*
+Reads the morphotactics. Unindented lines are headers (states) and the indented lines below them
+are their targets. Consecutive headers share the same targets. Anything following a # is a comment.
+
+A header or target that is a catalog tag, or the first part of one, stands for all the tags it matches.
+A target that is instead the name of another header includes all of that header's targets.
+The target END marks that a word may end after the state.
+*/
+func DecodeMorphotactics(r io.Reader, c *Catalog) (*Morphotactics, error) {
+	var blocks []*block
+	var cur *block
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		name := strings.TrimSpace(line)
+		if name == "" {
+			continue
+		}
+		if strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("morphotactics: line %d: more than one name %q", n, name)
+		}
+		if line[0] != ' ' && line[0] != '\t' {
+			if cur == nil || len(cur.targets) != 0 {
+				cur = &block{}
+				blocks = append(blocks, cur)
+			}
+			cur.states = append(cur.states, name)
+		} else {
+			if cur == nil {
+				return nil, fmt.Errorf("morphotactics: line %d: target %s without a state", n, name)
+			}
+			cur.targets = append(cur.targets, name)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	/* named groups are the headers that are not tags */
+	groups := map[string]*block{}
+	for _, b := range blocks {
+		for _, s := range b.states {
+			if len(c.Match(s)) == 0 {
+				if groups[s] != nil {
+					return nil, fmt.Errorf("morphotactics: state %s defined twice", s)
+				}
+				groups[s] = b
+			}
+		}
+	}
+
+	m := &Morphotactics{next: map[string][]string{}, final: map[string]bool{}}
+	for _, b := range blocks {
+		next, final, err := expand(b, c, groups, map[*block]bool{})
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range b.states {
+			states := c.Match(s)
+			if len(states) == 0 {
+				states = []string{s}
+			}
+			for _, state := range states {
+				m.next[state] = appendNew(m.next[state], next...)
+				m.final[state] = m.final[state] || final
+			}
+		}
+	}
+	return m, nil
+}
+
+/* flattens the targets of a block into tags, following included groups */
+func expand(b *block, c *Catalog, groups map[string]*block, seen map[*block]bool) (next []string, final bool, err error) {
+	if seen[b] {
+		return nil, false, nil
+	}
+	seen[b] = true
+	for _, t := range b.targets {
+		if t == End {
+			final = true
+		} else if tags := c.Match(t); len(tags) != 0 {
+			next = appendNew(next, tags...)
+		} else if g, ok := groups[t]; ok {
+			n, f, err := expand(g, c, groups, seen)
+			if err != nil {
+				return nil, false, err
+			}
+			next = appendNew(next, n...)
+			final = final || f
+		} else {
+			return nil, false, fmt.Errorf("morphotactics: unknown target %s", t)
+		}
+	}
+	return next, final, nil
+}
+
+/* appends the elements of ys not already in xs */
+func appendNew(xs []string, ys ...string) []string {
+	for _, y := range ys {
+		found := false
+		for _, x := range xs {
+			if x == y {
+				found = true
+				break
+			}
+		}
+		if !found {
+			xs = append(xs, y)
+		}
+	}
+	return xs
+}
+
+/* returns the tags of the suffixes that may follow state */
+func (m *Morphotactics) Next(state string) []string {
+	return m.next[state]
+}
+
+/*
+Returns the state that the suffixes of a lexicon entry start from: FLAG.ROOT for the first flag of the entry
+that is a state, such as TEMPORAL.ROOT for dün (dünkü), and otherwise POS.ROOT
+*/
+func (m *Morphotactics) Root(e Entry) string {
+	for _, f := range e.Flags {
+		if _, ok := m.next[f+".ROOT"]; ok {
+			return f + ".ROOT"
+		}
+	}
+	return e.POS + ".ROOT"
+}
+
+/* reports whether a word may end in state */
+func (m *Morphotactics) Final(state string) bool {
+	return m.final[state]
+}
@@ -0,0 +1,103 @@
+package analysis
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodeMorphotactics(t *testing.T) {
+	c, _ := DecodeCatalog(strings.NewReader(`
+PL = "lAr"
+[CASE]
+  ABSL = ""
+  LOC  = "DA"
+[POS]
+  1sg = "(I)m"
+  1pl = "(I)mIz"
+`))
+	m, err := DecodeMorphotactics(strings.NewReader(`
+# comment
+NOUN.ROOT   # root
+ADJ.ROOT
+	PL
+	POS
+	CASES
+	PL       # duplicates are ignored
+
+PL
+	POS.1pl
+	CASES
+
+POS
+	CASES
+
+CASES
+	CASE
+	END
+
+CASE
+	END
+`), c)
+	if err != nil {
+		t.Fatalf("DecodeMorphotactics() error: %v", err)
+	}
+
+	states := []string{"NOUN.ROOT", "ADJ.ROOT", "PL", "POS.1sg", "POS.1pl", "CASE.LOC", "CASES", "VERB.ROOT"}
+	next := [][]string{
+		[]string{"PL", "POS.1pl", "POS.1sg", "CASE.ABSL", "CASE.LOC"},
+		[]string{"PL", "POS.1pl", "POS.1sg", "CASE.ABSL", "CASE.LOC"},
+		[]string{"POS.1pl", "CASE.ABSL", "CASE.LOC"},
+		[]string{"CASE.ABSL", "CASE.LOC"},
+		[]string{"CASE.ABSL", "CASE.LOC"},
+		nil,
+		[]string{"CASE.ABSL", "CASE.LOC"},
+		nil,
+	}
+	final := []bool{true, true, true, true, true, true, true, false}
+	for i, s := range states {
+		if n := m.Next(s); !reflect.DeepEqual(n, next[i]) {
+			t.Errorf("Next(%s) = %v, expected %v", s, n, next[i])
+		}
+		if f := m.Final(s); f != final[i] {
+			t.Errorf("Final(%s) = %v, expected %v", s, f, final[i])
+		}
+	}
+
+	invalid := []string{
+		"\tPL\n",
+		"NOUN.ROOT\n\tVB\n",
+		"NOUN.ROOT\n\tPL CASE\n",
+		"X\n\tPL\nY\n\tPL\nX\n\tEND\n",
+	}
+	for _, s := range invalid {
+		if _, err := DecodeMorphotactics(strings.NewReader(s), c); err == nil {
+			t.Errorf("DecodeMorphotactics(%q) succeeded, expected an error", s)
+		}
+	}
+}
+
+func TestRoot(t *testing.T) {
+	c, _ := DecodeCatalog(strings.NewReader(`REL = "ki"`))
+	m, _ := DecodeMorphotactics(strings.NewReader(`
+NOUN.ROOT
+	END
+TEMPORAL.ROOT
+	REL
+	NOUN.ROOT
+REL
+	END
+`), c)
+	valid := []Entry{
+		{Root: []rune("ev"), POS: "NOUN"},
+		{Root: []rune("dün"), POS: "NOUN", Flags: []string{"TEMPORAL"}},
+		{Root: []rune("ankara"), POS: "NOUN", Flags: []string{"PROPER", "TEMPORAL"}},
+		{Root: []rune("ankara"), POS: "NOUN", Flags: []string{"PROPER"}},
+	}
+	valid_out := []string{"NOUN.ROOT", "TEMPORAL.ROOT", "TEMPORAL.ROOT", "NOUN.ROOT"}
+	for i, e := range valid {
+		if r := m.Root(e); r != valid_out[i] {
+			t.Errorf("Root(%v) = %s, expected %s", e, r, valid_out[i])
+		}
+	}
+}
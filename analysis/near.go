@@ -0,0 +1,79 @@
+package analysis
+
+import (
+	"sort"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+	"github.com/kaan9/turkish-morphology/normalize"
+)
+
+/* matches the words within edit distance max of the word w */
+type near struct {
+	w   []rune
+	max int
+}
+
+func (m near) prefix(stem inf.Stem) bool {
+	for _, d := range distances(stem[:len(stem)-1], m.w) {
+		if d <= m.max {
+			return true
+		}
+	}
+	return false
+}
+
+func (m near) word(word inf.Word) bool {
+	return distances(word, m.w)[len(m.w)] <= m.max
+}
+
+/*
+Returns the analyses of the words that are within edit distance max of a word (normalized as by Analyze),
+by running the morphotactics forward from every root of the lexicon and keeping the stems that stay close
+to the word. Each analysis has its phonological distance from the word (see inflection.Distance) added to its
+Penalty and they are ordered by it, so that a word differing by harmony or voicing comes before one differing
+by other letters: kitapda is closer to kitapta than to kitapla.
+*/
+func (an *Analyzer) Near(word string, max int) []Analysis {
+	m := near{[]rune(normalize.Lower(word)), max}
+	var res []Analysis
+	for _, e := range an.Lexicon.Entries {
+		if m.prefix(inf.Stem(e.Root)) {
+			an.searchFrom(m, e, nil, &res)
+		}
+	}
+	for i := range res {
+		res[i].Penalty += inf.Distance(res[i].Word, m.w)
+	}
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Penalty < res[j].Penalty })
+	return res
+}
+
+/*
+Returns the Levenshtein distances between s and each prefix of w: the last row of the table,
+whose element i is the distance between s and w[:i]
+*/
+func distances(s, w []rune) []int {
+	row := make([]int, len(w)+1)
+	for i := range row {
+		row[i] = i
+	}
+	for _, c := range s {
+		diag := row[0]
+		row[0]++
+		for i := 1; i <= len(w); i++ {
+			d := diag
+			if w[i-1] != c {
+				d++
+			}
+			diag = row[i]
+			if row[i]+1 < d {
+				d = row[i] + 1
+			}
+			if row[i-1]+1 < d {
+				d = row[i-1] + 1
+			}
+			row[i] = d
+		}
+	}
+	return row
+}
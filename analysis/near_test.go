@@ -0,0 +1,48 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNear(t *testing.T) {
+	an := load(t)
+	valid := []string{"kitaplerim", "evlrimiz", "gidiyorm", "ev", "kitapda"}
+	valid_out := []string{
+		"kitap[NOUN]+PL+POS.1sg+CASE.ABSL",
+		"ev[NOUN]+PL+POS.1pl+CASE.ABSL",
+		"git[VERB]+TAM.PRS.IPFV+PRED.1sg",
+		"ev[NOUN]+CASE.ABSL",
+		"kitap[NOUN]+CASE.LOC",
+	}
+	distance := []float64{1, 1, 1, 0, 0.5} /* kitaplerim and kitapda differ by harmony and voicing */
+	for i, w := range valid {
+		as := an.Near(w, 2)
+		if !found(as, valid_out[i]) {
+			t.Errorf("Near(%s, 2) = %v, expected to contain %s", w, as, valid_out[i])
+		}
+		if len(as) == 0 || as[0].Penalty != distance[i] || (distance[i] != 0 && as[0].String() != valid_out[i]) {
+			t.Errorf("Near(%s, 2) = %v, expected %s closest at distance %v", w, as, valid_out[i], distance[i])
+		}
+		for j, a := range as {
+			if a.Penalty > 2 || (j > 0 && a.Penalty < as[j-1].Penalty) {
+				t.Errorf("Near(%s, 2) = %v, expected distances up to 2 in order", w, as)
+				break
+			}
+		}
+	}
+
+	if as := an.Near("xyzxyzxyz", 2); len(as) != 0 {
+		t.Errorf("Near(xyzxyzxyz, 2) = %v, expected no analyses", as)
+	}
+}
+
+func TestDistances(t *testing.T) {
+	valid := [][2]string{{"kitap", "kitaplar"}, {"", "ev"}, {"gidiyorm", "gidiyorum"}, {"ev", ""}}
+	valid_out := [][]int{{5, 4, 3, 2, 1, 0, 1, 2, 3}, {0, 1, 2}, {8, 7, 6, 5, 4, 3, 2, 1, 1, 1}, {2}}
+	for i, v := range valid {
+		if d := distances([]rune(v[0]), []rune(v[1])); !reflect.DeepEqual(d, valid_out[i]) {
+			t.Errorf("distances(%s, %s) = %v, expected %v", v[0], v[1], d, valid_out[i])
+		}
+	}
+}
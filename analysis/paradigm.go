@@ -0,0 +1,76 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+)
+
+/* the order of the forms of a paradigm */
+type Order int
+
+const (
+	CatalogOrder Order = iota /* the sorted order of the tags of the catalog: 1pl 1sg 2pl ..., ABL ABSL ACC ... */
+	Textbook                  /* persons 1sg 2sg 3sg 1pl 2pl 3pl and cases ABSL ACC DAT LOC ABL GEN INS */
+)
+
+/* the rank in textbook order of the last key of a tag, tags without a rank follow in catalog order */
+var textbook = map[string]int{
+	"1sg": 1, "2sg": 2, "3sg": 3, "1pl": 4, "2pl": 5, "3pl": 6,
+	"ABSL": 1, "ACC": 2, "DAT": 3, "LOC": 4, "ABL": 5, "GEN": 6, "INS": 7,
+}
+
+/*
+Returns the paradigm of a lexicon entry: the forms of every combination of one tag from each slot, in order.
+A slot is a tag or a group of tags (see Catalog.Match), and a slot ending in ? may also be left out:
+
+	NOUN: PL? POS? CASE      the declension of a noun
+	VERB: TAM.PPFV.KNWN VB   the past tense conjugation of a verb
+
+Combinations that the morphotactics do not allow are skipped. The forms vary the last slot fastest and the
+tags of each slot are in the given order, the form without an optional slot first.
+*/
+func (an *Analyzer) Paradigm(e Entry, order Order, slots ...string) []Analysis {
+	alts := make([][]string, len(slots))
+	for i, slot := range slots {
+		if strings.HasSuffix(slot, "?") {
+			alts[i] = append(alts[i], "")
+			slot = strings.TrimSuffix(slot, "?")
+		}
+		alts[i] = append(alts[i], sortTags(an.Catalog.Match(slot), order)...)
+	}
+	var res []Analysis
+	var walk func(i int, tags []string)
+	walk = func(i int, tags []string) {
+		if i == len(alts) {
+			if a, ok := an.Generate(e, tags); ok {
+				res = append(res, a)
+			}
+			return
+		}
+		for _, tag := range alts[i] {
+			next := append([]string(nil), tags...)
+			if tag != "" {
+				next = append(next, tag)
+			}
+			walk(i+1, next)
+		}
+	}
+	walk(0, nil)
+	return res
+}
+
+/* returns the tags in the order, which are in catalog order */
+func sortTags(tags []string, order Order) []string {
+	if order != Textbook {
+		return tags
+	}
+	rank := func(tag string) int {
+		if r, ok := textbook[tag[strings.LastIndexByte(tag, '.')+1:]]; ok {
+			return r
+		}
+		return len(textbook) + 1
+	}
+	tags = append([]string(nil), tags...)
+	sort.SliceStable(tags, func(i, j int) bool { return rank(tags[i]) < rank(tags[j]) })
+	return tags
+}
@@ -0,0 +1,48 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func words(as []Analysis) []string {
+	ws := make([]string, len(as))
+	for i, a := range as {
+		ws[i] = a.Word.String()
+	}
+	return ws
+}
+
+func TestParadigm(t *testing.T) {
+	an := load(t)
+	ev := an.Entries("ev", "NOUN")[0]
+	gel := an.Entries("gel", "VERB")[0]
+
+	valid := []struct {
+		e     Entry
+		order Order
+		slots []string
+	}{
+		{gel, Textbook, []string{"TAM.PPFV.KNWN", "VB"}},
+		{gel, CatalogOrder, []string{"TAM.PPFV.KNWN", "VB"}},
+		{ev, Textbook, []string{"CASE"}},
+		{ev, CatalogOrder, []string{"CASE"}},
+		{ev, Textbook, []string{"PL?", "CASE.LOC"}},
+		{ev, Textbook, []string{"PL?", "POS.1sg?", "CASE.DAT"}},
+		{gel, Textbook, []string{"TAM.PPFV.KNWN", "CASE"}},
+	}
+	valid_out := [][]string{
+		{"geldim", "geldin", "geldi", "geldik", "geldiniz", "geldiler"},
+		{"geldik", "geldim", "geldiniz", "geldin", "geldiler", "geldi"},
+		{"ev", "evi", "eve", "evde", "evden", "evin", "evle"},
+		{"evden", "ev", "evi", "eve", "evin", "evle", "evde"},
+		{"evde", "evlerde"},
+		{"eve", "evime", "evlere", "evlerime"},
+		{},
+	}
+	for i, v := range valid {
+		if ws := words(an.Paradigm(v.e, v.order, v.slots...)); !reflect.DeepEqual(ws, valid_out[i]) {
+			t.Errorf("Paradigm(%s, %d, %v) = %v, expected %v", v.e.Root, v.order, v.slots, ws, valid_out[i])
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+/*
+Profiles holds analyzers by name, each with its own data directory and Options, so that one program can
+serve several configurations (a custom lexicon, a different Options.Lexicalized policy) chosen per request.
+Each profile is a Reloader, so its data can be reloaded while the others are in use.
+*/
+type Profiles struct {
+	mu       sync.RWMutex
+	profiles map[string]*Reloader
+}
+
+/* creates an empty set of profiles */
+func NewProfiles() *Profiles {
+	return &Profiles{profiles: map[string]*Reloader{}}
+}
+
+/* loads the data files in dir as the profile name with the options, replacing a profile of that name */
+func (p *Profiles) Add(name, dir string, opts Options) error {
+	r, err := NewReloader(dir)
+	if err != nil {
+		return fmt.Errorf("profile %s: %v", name, err)
+	}
+	r.Analyzer().Options = opts /* not yet shared */
+	p.mu.Lock()
+	p.profiles[name] = r
+	p.mu.Unlock()
+	return nil
+}
+
+/* removes the profile name */
+func (p *Profiles) Remove(name string) {
+	p.mu.Lock()
+	delete(p.profiles, name)
+	p.mu.Unlock()
+}
+
+/* returns the analyzer of the profile name, false if there is no such profile */
+func (p *Profiles) Analyzer(name string) (*Analyzer, bool) {
+	p.mu.RLock()
+	r, ok := p.profiles[name]
+	p.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return r.Analyzer(), true
+}
+
+/* reloads the data files of the profile name, see Reloader.Reload */
+func (p *Profiles) Reload(name string) error {
+	p.mu.RLock()
+	r, ok := p.profiles[name]
+	p.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("profile %s: no such profile", name)
+	}
+	if err := r.Reload(); err != nil {
+		return fmt.Errorf("profile %s: %v", name, err)
+	}
+	return nil
+}
+
+/* returns the names of the profiles in sorted order */
+func (p *Profiles) Names() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var names []string
+	for name := range p.profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,48 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestProfiles(t *testing.T) {
+	p := NewProfiles()
+	if err := p.Add("default", "..", Options{}); err != nil {
+		t.Fatalf("Add(default) error: %v", err)
+	}
+	if err := p.Add("lexicalized", "..", Options{Lexicalized: true}); err != nil {
+		t.Fatalf("Add(lexicalized) error: %v", err)
+	}
+	if err := p.Add("missing", "no such directory", Options{}); err == nil {
+		t.Errorf("Add(missing) succeeded, expected an error")
+	}
+	if names := p.Names(); !reflect.DeepEqual(names, []string{"default", "lexicalized"}) {
+		t.Errorf("Names() = %v, expected [default lexicalized]", names)
+	}
+
+	valid := []string{"default", "lexicalized"}
+	valid_out := []bool{true, false}
+	for i, name := range valid {
+		an, ok := p.Analyzer(name)
+		if !ok {
+			t.Fatalf("Analyzer(%s) not found", name)
+		}
+		if as := an.Analyze("öğretmen"); found(as, "öğret[VERB]+N.V.MAN+CASE.ABSL") != valid_out[i] {
+			t.Errorf("Analyzer(%s).Analyze(öğretmen) = %v, expected öğret[VERB]+N.V.MAN: %v", name, as, valid_out[i])
+		}
+	}
+
+	if err := p.Reload("lexicalized"); err != nil {
+		t.Errorf("Reload(lexicalized) error: %v", err)
+	}
+	if an, _ := p.Analyzer("lexicalized"); !an.Options.Lexicalized {
+		t.Errorf("Reload(lexicalized) did not keep the Options")
+	}
+	p.Remove("default")
+	if _, ok := p.Analyzer("default"); ok {
+		t.Errorf("Analyzer(default) found after Remove")
+	}
+	if err := p.Reload("default"); err == nil {
+		t.Errorf("Reload(default) succeeded after Remove, expected an error")
+	}
+}
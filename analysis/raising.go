@@ -0,0 +1,27 @@
+package analysis
+
+import (
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/* the verbs whose e is raised to i before the buffer consonant y of a suffix: yiyecek, diyecek */
+var raising = map[string]bool{"ye": true, "de": true}
+
+/*
+Returns the stem of a verb root followed by a suffix with the e of ye and de raised to i before the buffer y:
+yiyin, yiyiniz, yiyelim, yiyebilir, diyelim, diyebilir, diye. The e of de is only raised before a low vowel,
+so de keeps it before a high one: deyin, deyiniz, deyip (but yiyip). The -Iyor replacing the e keeps its front
+harmony, which the stem without a vowel before its final character does not give: yiyor, diyor.
+*/
+func raise(a Analysis, stem inf.Stem) inf.Stem {
+	if len(a.Tags) != 0 || a.POS != "VERB" || !raising[a.Lemma()] || len(stem) < 4 {
+		return stem
+	}
+	if stem[1] == 'ı' {
+		stem[1] = 'i' /* -Iyor */
+	}
+	if stem[2] == 'y' && (a.Lemma() == "ye" || stem[3] == 'a' || stem[3] == 'e') {
+		stem[1] = 'i'
+	}
+	return stem
+}
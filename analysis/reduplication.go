@@ -0,0 +1,33 @@
+package analysis
+
+/* the tag of the converb -(y)A, which doubled forms an adverb of manner: koşa koşa, güle güle */
+const Converb = "CVB.V.1"
+
+/*
+Generates the reduplicated converb of a verb of the lexicon, its -(y)A converb written twice as one adverbial
+unit: koş -> koşa koşa, gül -> güle güle, ağla -> ağlaya ağlaya. Returns false if the converb cannot be generated.
+*/
+func (an *Analyzer) Reduplicate(e Entry) (string, bool) {
+	a, ok := an.Generate(e, []string{Converb})
+	if !ok {
+		return "", false
+	}
+	return a.Word.String() + " " + a.Word.String(), true
+}
+
+/*
+Returns the analyses of the half of a reduplication that are the converb -(y)A, which form a single adverbial
+unit (koşa koşa is koş[VERB]+CVB.V.1 and not the optative koşa twice), or all analyses if none are.
+*/
+func reduplicated(as []Analysis) []Analysis {
+	var res []Analysis
+	for _, a := range as {
+		if len(a.Tags) != 0 && a.Tags[len(a.Tags)-1] == Converb {
+			res = append(res, a)
+		}
+	}
+	if res == nil {
+		return as
+	}
+	return res
+}
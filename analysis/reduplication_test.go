@@ -0,0 +1,29 @@
+package analysis
+
+import (
+	"testing"
+)
+
+func TestReduplicate(t *testing.T) {
+	an := load(t)
+	valid := [][2]string{{"gül", "VERB"}, {"koş", "VERB"}, {"ağla", "VERB"}, {"yürü", "VERB"}}
+	valid_out := []string{"güle güle", "koşa koşa", "ağlaya ağlaya", "yürüye yürüye"}
+	for i, v := range valid {
+		if s, ok := an.Reduplicate(an.Entries(v[0], v[1])[0]); !ok || s != valid_out[i] {
+			t.Errorf("Reduplicate(%s) = (%s, %v), expected %s", v[0], s, ok, valid_out[i])
+		}
+		as := an.AnalyzeWritten(valid_out[i])
+		if len(as) == 0 {
+			t.Errorf("AnalyzeWritten(%s) = %v, expected analyses", valid_out[i], as)
+		}
+		for _, a := range as {
+			if a.String() != v[0]+"[VERB]+"+Converb {
+				t.Errorf("AnalyzeWritten(%s) = %v, expected only the converb", valid_out[i], as)
+			}
+		}
+	}
+
+	if s, ok := an.Reduplicate(an.Entries("ev", "NOUN")[0]); ok {
+		t.Errorf("Reduplicate(ev) = %s, expected to fail", s)
+	}
+}
@@ -0,0 +1,51 @@
+package analysis
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+/*
+A Reloader holds the Analyzer of the data files in a directory and replaces it when the files are loaded
+again, for long-running programs whose data is updated. An Analyzer returned by Analyzer is never changed,
+so work in progress finishes with the data it started with while new work uses the reloaded data.
+*/
+type Reloader struct {
+	Dir string
+
+	mu      sync.Mutex   /* serializes reloads */
+	current atomic.Value /* *Analyzer */
+}
+
+/* creates a Reloader of the data files in dir, see Load */
+func NewReloader(dir string) (*Reloader, error) {
+	an, err := Load(dir)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reloader{Dir: dir}
+	r.current.Store(an)
+	return r, nil
+}
+
+/* returns the analyzer of the data files as last loaded */
+func (r *Reloader) Analyzer() *Analyzer {
+	return r.current.Load().(*Analyzer)
+}
+
+/*
+Loads the data files again and replaces the analyzer, keeping its Options and Weights.
+If the files cannot be loaded the analyzer is kept and the error is returned.
+*/
+func (r *Reloader) Reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	an, err := Load(r.Dir)
+	if err != nil {
+		return err
+	}
+	old := r.Analyzer()
+	an.Options, an.Weights = old.Options, old.Weights
+	r.current.Store(an)
+	return nil
+}
@@ -0,0 +1,57 @@
+package analysis
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{CatalogFile, MorphotacticFile, LexiconFile} {
+		b, err := ioutil.ReadFile(filepath.Join("..", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), b, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r, err := NewReloader(dir)
+	if err != nil {
+		t.Fatalf("NewReloader() error: %v", err)
+	}
+	old := r.Analyzer()
+	if as := old.Analyze("zeytinler"); len(as) != 0 {
+		t.Fatalf("Analyze(zeytinler) = %v before the root is added", as)
+	}
+
+	lex := filepath.Join(dir, LexiconFile)
+	f, _ := os.OpenFile(lex, os.O_APPEND|os.O_WRONLY, 0644)
+	f.WriteString("zeytin NOUN\n")
+	f.Close()
+	old.Options.Lexicalized = true
+	if err := r.Reload(); err != nil {
+		t.Fatalf("Reload() error: %v", err)
+	}
+	if as := r.Analyzer().Analyze("zeytinler"); !found(as, "zeytin[NOUN]+PL+CASE.ABSL") {
+		t.Errorf("Analyze(zeytinler) = %v after Reload, expected zeytin[NOUN]+PL+CASE.ABSL", as)
+	}
+	if as := old.Analyze("zeytinler"); len(as) != 0 {
+		t.Errorf("Analyze(zeytinler) = %v with the analyzer from before Reload, expected none", as)
+	}
+	if !r.Analyzer().Options.Lexicalized {
+		t.Errorf("Reload() did not keep the Options")
+	}
+
+	ioutil.WriteFile(lex, []byte("ev\n"), 0644)
+	an := r.Analyzer()
+	if err := r.Reload(); err == nil || r.Analyzer() != an {
+		t.Errorf("Reload() of an invalid lexicon = %v and replaced the analyzer, expected an error", err)
+	}
+}
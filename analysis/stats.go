@@ -0,0 +1,157 @@
+package analysis
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/*
+Stats are frequency counts over the analyses of a corpus. An ambiguous token adds an equal fraction
+of 1 to each of its analyses so that the counts do not depend on any particular disambiguation.
+The keys are
+
+	Roots:       the root and its part of speech, e.g. ev[NOUN]
+	Tags:        a suffix tag, e.g. CASE.LOC
+	Transitions: two consecutive states of the morphotactics, from the root to END, e.g. PL>CASE.LOC
+	Chains:      the part of speech followed by the whole suffix chain, e.g. NOUN+PL+CASE.LOC
+*/
+type Stats struct {
+	Tokens, Analyzed int
+
+	Roots       map[string]float64
+	Tags        map[string]float64
+	Transitions map[string]float64
+	Chains      map[string]float64
+}
+
+/* the kinds of counts, in the order they are written */
+var stats_kinds = []string{"root", "tag", "transition", "chain"}
+
+func NewStats() *Stats {
+	return &Stats{
+		Roots:       map[string]float64{},
+		Tags:        map[string]float64{},
+		Transitions: map[string]float64{},
+		Chains:      map[string]float64{},
+	}
+}
+
+func (st *Stats) counts(kind string) map[string]float64 {
+	switch kind {
+	case "root":
+		return st.Roots
+	case "tag":
+		return st.Tags
+	case "transition":
+		return st.Transitions
+	case "chain":
+		return st.Chains
+	}
+	return nil
+}
+
+/* counts a token given all of its analyses */
+func (st *Stats) Add(as []Analysis) {
+	st.Tokens++
+	if len(as) == 0 {
+		return
+	}
+	st.Analyzed++
+	w := 1 / float64(len(as))
+	for _, a := range as {
+		st.Roots[RootKey(a)] += w
+		st.Chains[ChainKey(a)] += w
+		prev := a.POS + ".ROOT"
+		for _, tag := range a.Tags {
+			st.Tags[tag] += w
+			st.Transitions[prev+">"+tag] += w
+			prev = tag
+		}
+		st.Transitions[prev+">"+End] += w
+	}
+}
+
+/* the key of the root of an analysis in Stats.Roots */
+func RootKey(a Analysis) string {
+	return a.Lemma() + "[" + a.POS + "]"
+}
+
+/* the key of the suffix chain of an analysis in Stats.Chains */
+func ChainKey(a Analysis) string {
+	return strings.Join(append([]string{a.POS}, a.Tags...), "+")
+}
+
+/*
+Writes the stats, one count per line as: KIND KEY COUNT, most frequent first within each kind.
+Counts are written exactly, so ReadStats reads back the same stats.
+If n > 0 only the n most frequent keys of each kind are written.
+*/
+func (st *Stats) Write(w io.Writer, n int) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "# tokens %d analyzed %d\n", st.Tokens, st.Analyzed)
+	for _, kind := range stats_kinds {
+		counts := st.counts(kind)
+		keys := make([]string, 0, len(counts))
+		for k := range counts {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if counts[keys[i]] != counts[keys[j]] {
+				return counts[keys[i]] > counts[keys[j]]
+			}
+			return keys[i] < keys[j]
+		})
+		if n > 0 && len(keys) > n {
+			keys = keys[:n]
+		}
+		for _, k := range keys {
+			c := strconv.FormatFloat(counts[k], 'g', -1, 64)
+			fmt.Fprintf(bw, "%s\t%s\t%s\n", kind, k, c)
+		}
+	}
+	return bw.Flush()
+}
+
+/* reads stats written by Stats.Write from the file at path */
+func LoadStats(path string) (*Stats, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadStats(f)
+}
+
+/* reads stats written by Stats.Write */
+func ReadStats(r io.Reader) (*Stats, error) {
+	st := NewStats()
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			fmt.Sscanf(line, "# tokens %d analyzed %d", &st.Tokens, &st.Analyzed)
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 || st.counts(fields[0]) == nil {
+			return nil, fmt.Errorf("stats: line %d: expected KIND KEY COUNT", n)
+		}
+		c, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("stats: line %d: %v", n, err)
+		}
+		st.counts(fields[0])[fields[1]] += c
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return st, nil
+}
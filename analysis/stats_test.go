@@ -0,0 +1,69 @@
+package analysis
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	an := load(t)
+	st := NewStats()
+	for _, w := range []string{"evlerimizden", "evde", "kitabı", "xyz", "evde"} {
+		st.Add(an.Analyze(w))
+	}
+	if st.Tokens != 5 || st.Analyzed != 4 {
+		t.Errorf("Tokens, Analyzed = %d, %d, expected %d, %d", st.Tokens, st.Analyzed, 5, 4)
+	}
+
+	/* kitabı is split between POS.3sg+CASE.ABSL and CASE.ACC */
+	counts := map[string]float64{
+		"ev[NOUN]":                 3,
+		"kitap[NOUN]":              1,
+		"CASE.LOC":                 2,
+		"CASE.ACC":                 0.5,
+		"NOUN.ROOT>PL":             1,
+		"CASE.ABL>END":             1,
+		"NOUN+PL+POS.1pl+CASE.ABL": 1,
+		"NOUN+POS.3sg+CASE.ABSL":   0.5,
+	}
+	all := map[string]float64{}
+	for _, m := range []map[string]float64{st.Roots, st.Tags, st.Transitions, st.Chains} {
+		for k, c := range m {
+			all[k] += c
+		}
+	}
+	for k, c := range counts {
+		if all[k] != c {
+			t.Errorf("count of %s = %v, expected %v", k, all[k], c)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := st.Write(&buf, 0); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	read, err := ReadStats(&buf)
+	if err != nil {
+		t.Fatalf("ReadStats() error: %v", err)
+	}
+	if !reflect.DeepEqual(read, st) {
+		t.Errorf("ReadStats(Write()) = %v, expected %v", read, st)
+	}
+
+	/* a word with three analyses adds a third to each, which must not be rounded */
+	st.Roots["üç[NUM]"] = 1.0 / 3
+	st.Roots["iki[NUM]"] = 2.0/3 + 1e-12
+	buf.Reset()
+	st.Write(&buf, 0)
+	if read, _ = ReadStats(&buf); !reflect.DeepEqual(read, st) {
+		t.Errorf("ReadStats(Write()) = %v, expected %v", read, st)
+	}
+
+	buf.Reset()
+	st.Write(&buf, 1)
+	read, _ = ReadStats(&buf)
+	if len(read.Roots) != 1 || read.Roots["ev[NOUN]"] != 3 || len(read.Chains) != 1 {
+		t.Errorf("ReadStats(Write(1)) = %v, expected only the most frequent key of each kind", read)
+	}
+}
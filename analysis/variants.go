@@ -0,0 +1,345 @@
+package analysis
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+	"github.com/kaan9/turkish-morphology/normalize"
+	"github.com/kaan9/turkish-morphology/numerals"
+)
+
+/* A Variant records that a word was written in a nonstandard way: its standard writing and why */
+type Variant struct {
+	Standard string
+	Note     string
+}
+
+/* the notes of variants */
+const (
+	NoteMissingApostrophe   = "suffixes of proper nouns and numbers are separated by an apostrophe"
+	NoteMisplacedApostrophe = "the apostrophe separates a proper noun or number from its suffixes"
+	NoteDerivational        = "derivational suffixes are not separated by an apostrophe"
+	NoteCommonNoun          = "only suffixes of proper nouns and numbers are separated by an apostrophe"
+	NoteHyphen              = "reduplications are written as two words without a hyphen"
+	NoteQuestion            = "the interrogative particle mI is written separately"
+	NoteForeignPlural       = "foreign plurals used as singulars do not take the plural suffix"
+	NoteJoined              = "the word is written without a hyphen or space"
+	NotePhrase              = "only the last word of the phrase is analyzed"
+)
+
+/* the apostrophes that may separate suffixes, the first is used in standard writings */
+const apostrophes = "'’"
+
+/* the lexicon flag of proper nouns */
+const Proper = "PROPER"
+
+/* the lexicon flag of foreign plurals used as singulars: evrak (documents), eşya (things), medya */
+const ForeignPlural = "FOREIGNPL"
+
+/* a policy for the plural of a foreign plural used as a singular */
+type PluralPolicy int
+
+const (
+	Descriptive  PluralPolicy = iota /* evraklar is a correct plural, as it is commonly written */
+	Prescriptive                     /* evraklar is a double plural, a variant of the standard evrak */
+)
+
+/*
+Analyzes a word as it is written in text, which may be capitalized and contain an apostrophe, and accepts
+variant writings, noting their standard writing in the Variant of each analysis:
+
+	an apostrophe that is missing, misplaced or not needed: Ankarada, Anka'rada, Ankara'lı, kitap'ta
+	suffixes of a number with or without an apostrophe: 2nci, 2'nci
+	a reduplication with or without a hyphen: yapa-yapa, yapa yapa
+	another word split by a hyphen or space that is a word without it: Ankara-da -> Ankara'da
+	with Options.Lenient, the interrogative particle attached to a word without analyses of its own (only guessed ones): geliyormusun, varmı
+	with a Prescriptive Options.ForeignPlurals, the double plural of a foreign plural: evraklar -> evrak
+
+A reduplication has the analyses of its half, only those of the -(y)A converb if it has any (see Reduplicate).
+Other words with a hyphen or space that are not a word without it have the analyses of their last word, which
+takes the suffixes of a phrase (buz-dolabı, koşa koşa koşa), noted by NotePhrase.
+The analyses of a number are those of its last word
+as pronounced followed by the written suffixes: 2'nci is analyzed as iki[NUM]+NUM.ORD
+*/
+func (an *Analyzer) AnalyzeWritten(written string) []Analysis {
+	return an.analyzeWritten(written, an.Options.Lenient)
+}
+
+/* AnalyzeWritten, accepting an attached interrogative particle if lenient */
+func (an *Analyzer) analyzeWritten(written string, lenient bool) []Analysis {
+	if i := separator(written); i >= 0 {
+		if res := an.analyzeReduplication(written[:i], written[i:i+1], written[i+1:]); len(res) != 0 {
+			return res
+		}
+		return an.analyzeSeparated(written, lenient)
+	}
+
+	/* the written word without its apostrophe and the position of the apostrophe */
+	r := []rune(written)
+	apos, sign := -1, []rune(apostrophes)[0]
+	if i := strings.IndexAny(written, apostrophes); i >= 0 {
+		apos = len([]rune(written[:i]))
+		sign = r[apos]
+		r = append(r[:apos:apos], r[apos+1:]...)
+	}
+
+	d := 0
+	for d < len(r) && (unicode.IsDigit(r[d]) || ((r[d] == '.' || r[d] == ',') && d+1 < len(r) && unicode.IsDigit(r[d+1]))) {
+		d++
+	}
+	if d > 0 {
+		return an.analyzeNumber(string(r[:d]), string(r[d:]), apos, sign, written)
+	}
+
+	var res []Analysis
+	for _, a := range an.Analyze(normalize.Lower(string(r))) {
+		proper := contains(a.Flags, Proper)
+		derived := false
+		for _, tag := range a.Tags {
+			derived = derived || derivational(tag)
+		}
+		boundary := len(a.Root)
+		std, note := string(r), ""
+		if proper && !derived && len(a.Word) > boundary {
+			std = string(r[:boundary]) + string(sign) + string(r[boundary:])
+			if apos < 0 {
+				note = NoteMissingApostrophe
+			} else if apos != boundary {
+				note = NoteMisplacedApostrophe
+			}
+		} else if apos >= 0 {
+			if proper && derived {
+				note = NoteDerivational
+			} else if proper {
+				note = NoteMisplacedApostrophe
+			} else {
+				note = NoteCommonNoun
+			}
+		}
+		if an.Options.ForeignPlurals == Prescriptive && contains(a.Flags, ForeignPlural) {
+			if s, ok := an.singular(a); ok {
+				std, note = s, strings.TrimPrefix(note+"; "+NoteForeignPlural, "; ")
+				if unicode.IsUpper(r[0]) {
+					first, size := utf8.DecodeRuneInString(std)
+					std = normalize.Upper(string(first)) + std[size:]
+				}
+			}
+		}
+		if note != "" {
+			a.Variant = &Variant{Standard: std, Note: note}
+		}
+		res = append(res, a)
+	}
+	if lenient && apos < 0 && guessed(res) {
+		if q := an.analyzeQuestion(written); len(q) != 0 {
+			return q
+		}
+	}
+	return res
+}
+
+/*
+analyzes a word followed by the interrogative particle written attached to it as the analyses of the word,
+with the particle separated in their standard writing: geliyormusun -> geliyor musun. The particle must
+harmonize with the word (geliyormisin is not geliyor misin).
+*/
+func (an *Analyzer) analyzeQuestion(written string) []Analysis {
+	r, lower := []rune(written), []rune(normalize.Lower(written))
+	if len(r) != len(lower) {
+		return nil
+	}
+	for i := len(r) - 2; i > 0; i-- {
+		if lower[i] != 'm' || !question(an.Analyze(string(lower[i:]))) {
+			continue
+		}
+		if h := inf.Stem(lower[:i]).Append(particle).Word(); h[len(h)-1] != lower[i+1] {
+			continue
+		}
+		res := an.analyzeWritten(string(r[:i]), false)
+		for k, a := range res {
+			std, notes := string(r[:i]), []string(nil)
+			if a.Variant != nil {
+				std, notes = a.Variant.Standard, []string{a.Variant.Note}
+			}
+			res[k].Variant = &Variant{Standard: std + " " + string(r[i:]), Note: strings.Join(append(notes, NoteQuestion), "; ")}
+		}
+		if !guessed(res) {
+			return res
+		}
+	}
+	return nil
+}
+
+/* reports whether a word has no analysis of its own: it has none or only guessed ones (see Options.GuessRoots) */
+func guessed(as []Analysis) bool {
+	for _, a := range as {
+		if !a.Guessed {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+returns the word of the analysis of a foreign plural without the plural suffix of the root, which makes it a
+double plural: evraklarımız -> evrakımız. The plural predicate is agreement with a plural subject, not a double
+plural (onlar akrabalar, they are relatives). Returns false if the root is not pluralized.
+*/
+func (an *Analyzer) singular(a Analysis) (string, bool) {
+	for i, tag := range a.Tags {
+		if derivational(tag) {
+			break
+		}
+		if tag == "PL" {
+			tags := append(append([]string(nil), a.Tags[:i]...), a.Tags[i+1:]...)
+			b, ok := an.Generate(Entry{Root: a.Root, POS: a.POS, Flags: a.Flags}, tags)
+			return b.Word.String(), ok
+		}
+	}
+	return "", false
+}
+
+/* the interrogative particle, which takes the vowel of the word before it */
+var particle = inf.Suffix{Body: []rune("mI")}
+
+/* reports whether one of the analyses is the interrogative particle */
+func question(as []Analysis) bool {
+	for _, a := range as {
+		if a.POS == "QUES" {
+			return true
+		}
+	}
+	return false
+}
+
+/* analyzes the suffixes written after a number as suffixes of its last pronounced word */
+func (an *Analyzer) analyzeNumber(digits, suffixes string, apos int, sign rune, written string) []Analysis {
+	last, ok := numerals.LastWord(digits)
+	if !ok {
+		return nil
+	}
+	w := append([]rune(last.Word()), []rune(normalize.Lower(suffixes))...)
+	var res []Analysis
+	an.analyzeFrom(Entry{Root: inf.Root(last), POS: "NUM"}, w, len(last), nil, &res)
+
+	std := digits
+	if suffixes != "" {
+		std += string(sign) + suffixes
+	}
+	for i := range res {
+		if std != written {
+			note := NoteMissingApostrophe
+			if apos >= 0 {
+				note = NoteMisplacedApostrophe
+			}
+			res[i].Variant = &Variant{Standard: std, Note: note}
+		}
+	}
+	return res
+}
+
+/* analyzes two equal halves separated by a hyphen or a space as a reduplication */
+func (an *Analyzer) analyzeReduplication(first, sep, second string) []Analysis {
+	if normalize.Lower(first) != normalize.Lower(second) {
+		return nil
+	}
+	res := reduplicated(an.AnalyzeWritten(first))
+	for i, a := range res {
+		std, notes := first, []string(nil)
+		if a.Variant != nil {
+			std, notes = a.Variant.Standard, []string{a.Variant.Note}
+		}
+		if sep != " " {
+			notes = append(notes, NoteHyphen)
+		}
+		if notes != nil {
+			res[i].Variant = &Variant{Standard: std + " " + std, Note: strings.Join(notes, "; ")}
+		}
+	}
+	return res
+}
+
+/*
+returns the index of the first separator of the written word: a space or, as in the tokenizer, a hyphen between
+letters (yapa-yapa, Ankara-da). Returns -1 if there is none, since other hyphens belong to the word: -3, 3-4, ev-
+*/
+func separator(written string) int {
+	for i, r := range written {
+		if r == ' ' {
+			return i
+		}
+		if r == '-' {
+			before, _ := utf8.DecodeLastRuneInString(written[:i])
+			after, _ := utf8.DecodeRuneInString(written[i+1:])
+			if unicode.IsLetter(before) && unicode.IsLetter(after) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+/* returns the nonempty words of the written word between its separators (see separator) */
+func separated(written string) []string {
+	var words []string
+	for i := separator(written); i >= 0; i = separator(written) {
+		words = append(words, written[:i])
+		written = written[i+1:]
+	}
+	words = append(words, written)
+	res := words[:0]
+	for _, w := range words {
+		if w != "" {
+			res = append(res, w)
+		}
+	}
+	return res
+}
+
+/*
+analyzes a word split by separators (see separator) that is not a reduplication as the word without them, or
+else as its last word, with the words separated by spaces in its standard writing
+*/
+func (an *Analyzer) analyzeSeparated(written string, lenient bool) []Analysis {
+	words := separated(written)
+	joined := strings.Join(words, "")
+	if joined == "" {
+		return nil
+	}
+	res := an.analyzeWritten(joined, lenient)
+	note := NoteJoined
+	std := func(a Analysis) string {
+		if a.Variant != nil {
+			return a.Variant.Standard
+		}
+		return joined
+	}
+	if len(res) == 0 {
+		last := words[len(words)-1]
+		res = an.analyzeWritten(last, lenient)
+		note = NotePhrase
+		std = func(a Analysis) string {
+			w := last
+			if a.Variant != nil {
+				w = a.Variant.Standard
+			}
+			return strings.Join(append(words[:len(words)-1:len(words)-1], w), " ")
+		}
+	}
+	for i, a := range res {
+		notes := []string{note}
+		if a.Variant != nil {
+			notes = []string{a.Variant.Note, note}
+		}
+		res[i].Variant = &Variant{Standard: std(a), Note: strings.Join(notes, "; ")}
+	}
+	return res
+}
+
+/* derivational suffixes change the part of speech (see Derivation) */
+func derivational(tag string) bool {
+	_, _, ok := Derivation(tag)
+	return ok
+}
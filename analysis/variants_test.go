@@ -0,0 +1,235 @@
+package analysis
+
+import (
+	"testing"
+)
+
+func TestAnalyzeWritten(t *testing.T) {
+	an := load(t)
+	valid := []string{
+		"Ankara'da", "Ankarada", "Anka'rada", "Ankaralı", "Ankara'lı", "kitap'ta", "kitapta", "Ankara",
+		"2'nci", "2nci", "4'e", "1960'larda", "3", "yapa yapa", "yapa-yapa", "ankara-ankara", "İstanbul’a",
+	}
+	valid_out := []string{
+		"ankara[NOUN]+CASE.LOC",
+		"ankara[NOUN]+CASE.LOC",
+		"ankara[NOUN]+CASE.LOC",
+		"ankara[NOUN]+N.N.LI+CASE.ABSL",
+		"ankara[NOUN]+N.N.LI+CASE.ABSL",
+		"kitap[NOUN]+CASE.LOC",
+		"kitap[NOUN]+CASE.LOC",
+		"ankara[NOUN]+CASE.ABSL",
+		"iki[NUM]+NUM.ORD+CASE.ABSL",
+		"iki[NUM]+NUM.ORD+CASE.ABSL",
+		"dört[NUM]+CASE.DAT",
+		"altmış[NUM]+PL+CASE.LOC",
+		"üç[NUM]+CASE.ABSL",
+		"yap[VERB]+CVB.V.1",
+		"yap[VERB]+CVB.V.1",
+		"ankara[NOUN]+CASE.ABSL",
+		"istanbul[NOUN]+CASE.DAT",
+	}
+	variants := []*Variant{
+		nil,
+		&Variant{Standard: "Ankara'da", Note: NoteMissingApostrophe},
+		&Variant{Standard: "Ankara'da", Note: NoteMisplacedApostrophe},
+		nil,
+		&Variant{Standard: "Ankaralı", Note: NoteDerivational},
+		&Variant{Standard: "kitapta", Note: NoteCommonNoun},
+		nil,
+		nil,
+		nil,
+		&Variant{Standard: "2'nci", Note: NoteMissingApostrophe},
+		nil,
+		nil,
+		nil,
+		nil,
+		&Variant{Standard: "yapa yapa", Note: NoteHyphen},
+		&Variant{Standard: "ankara ankara", Note: NoteHyphen},
+		nil,
+	}
+	for i, w := range valid {
+		var a *Analysis
+		as := an.AnalyzeWritten(w)
+		for j := range as {
+			if as[j].String() == valid_out[i] {
+				a = &as[j]
+			}
+		}
+		if a == nil {
+			t.Errorf("AnalyzeWritten(%s) = %v, expected to contain %s", w, as, valid_out[i])
+		} else if (a.Variant == nil) != (variants[i] == nil) || (a.Variant != nil && *a.Variant != *variants[i]) {
+			t.Errorf("AnalyzeWritten(%s) variant = %+v, expected %+v", w, a.Variant, variants[i])
+		}
+	}
+
+	invalid := []string{"12x", "2'nci'", "1.45'te", "-", "x y", "xyz-"}
+	for _, w := range invalid {
+		if as := an.AnalyzeWritten(w); len(as) != 0 {
+			t.Errorf("AnalyzeWritten(%s) = %v, expected no analyses", w, as)
+		}
+	}
+}
+
+func TestAnalyzeWrittenLenient(t *testing.T) {
+	an := load(t)
+	valid := []string{"geliyormusun", "Geliyormusun", "varmı", "yokmu", "yokmuydu", "gelecekmiydin", "Ankaradamı", "evdemiyiz"}
+	valid_out := []string{
+		"gel[VERB]+TAM.PRS.IPFV+PRED.3sg",
+		"gel[VERB]+TAM.PRS.IPFV+PRED.3sg",
+		"var[EXIST]",
+		"yok[EXIST]",
+		"yok[EXIST]",
+		"gel[VERB]+TAM.FUT+PRED.3sg",
+		"ankara[NOUN]+CASE.LOC",
+		"ev[NOUN]+CASE.LOC",
+	}
+	variants := []Variant{
+		{Standard: "geliyor musun", Note: NoteQuestion},
+		{Standard: "Geliyor musun", Note: NoteQuestion},
+		{Standard: "var mı", Note: NoteQuestion},
+		{Standard: "yok mu", Note: NoteQuestion},
+		{Standard: "yok muydu", Note: NoteQuestion},
+		{Standard: "gelecek miydin", Note: NoteQuestion},
+		{Standard: "Ankara'da mı", Note: NoteMissingApostrophe + "; " + NoteQuestion},
+		{Standard: "evde miyiz", Note: NoteQuestion},
+	}
+	for _, w := range valid {
+		if as := an.AnalyzeWritten(w); len(as) != 0 {
+			t.Errorf("AnalyzeWritten(%s) = %v without Options.Lenient, expected no analyses", w, as)
+		}
+	}
+	an.Options.Lenient = true
+	for i, w := range valid {
+		var a *Analysis
+		as := an.AnalyzeWritten(w)
+		for j := range as {
+			if as[j].String() == valid_out[i] {
+				a = &as[j]
+			}
+		}
+		if a == nil {
+			t.Errorf("AnalyzeWritten(%s) = %v, expected to contain %s", w, as, valid_out[i])
+		} else if a.Variant == nil || *a.Variant != variants[i] {
+			t.Errorf("AnalyzeWritten(%s) variant = %+v, expected %+v", w, a.Variant, variants[i])
+		}
+	}
+
+	invalid := []string{"geliyormisin", "evmu", "xyzmi", "geliyormusunx"}
+	for _, w := range invalid {
+		if as := an.AnalyzeWritten(w); len(as) != 0 {
+			t.Errorf("AnalyzeWritten(%s) = %v, expected no analyses", w, as)
+		}
+	}
+	if as := an.AnalyzeWritten("evde"); len(as) == 0 || as[0].Variant != nil {
+		t.Errorf("AnalyzeWritten(evde) = %v, expected a standard analysis", as)
+	}
+
+	/* guessed roots do not hide the particle */
+	an.Options.GuessRoots = true
+	guessed := []string{"geliyormusun", "kitapmı"}
+	guessed_out := []string{"gel[VERB]+TAM.PRS.IPFV+PRED.3sg", "kitap[NOUN]+CASE.ABSL"}
+	guessed_std := []string{"geliyor musun", "kitap mı"}
+	for i, w := range guessed {
+		as := an.AnalyzeWritten(w)
+		if len(as) == 0 || as[0].Guessed || as[0].String() != guessed_out[i] || as[0].Variant == nil || as[0].Variant.Standard != guessed_std[i] {
+			t.Errorf("AnalyzeWritten(%s) = %v with Options.GuessRoots, expected %s written %s", w, as, guessed_out[i], guessed_std[i])
+		}
+	}
+	if as := an.AnalyzeWritten("xyzmi"); len(as) == 0 || !as[0].Guessed {
+		t.Errorf("AnalyzeWritten(xyzmi) = %v with Options.GuessRoots, expected guessed analyses", as)
+	}
+}
+
+func TestAnalyzeWrittenForeignPlural(t *testing.T) {
+	an := load(t)
+	valid := []string{"evraklar", "Eşyalarımızı", "akrabalarla", "evrakı", "medyada", "akrabalar", "esnaflar"}
+	valid_out := []string{
+		"evrak[NOUN]+PL+CASE.ABSL",
+		"eşya[NOUN]+PL+POS.1pl+CASE.ACC",
+		"akraba[NOUN]+PL+CASE.INS",
+		"evrak[NOUN]+CASE.ACC",
+		"medya[NOUN]+CASE.LOC",
+		"akraba[NOUN]+CASE.ABSL+PRED.3pl", /* onlar akrabalar: agreement, not a double plural */
+		"esnaf[NOUN]+CASE.ABSL+PRED.3pl",
+	}
+	variants := []*Variant{
+		&Variant{Standard: "evrak", Note: NoteForeignPlural},
+		&Variant{Standard: "Eşyamızı", Note: NoteForeignPlural},
+		&Variant{Standard: "akrabayla", Note: NoteForeignPlural},
+		nil,
+		nil,
+		nil,
+		nil,
+	}
+	for _, policy := range []PluralPolicy{Descriptive, Prescriptive} {
+		an.Options.ForeignPlurals = policy
+		for i, w := range valid {
+			var a *Analysis
+			as := an.AnalyzeWritten(w)
+			for j := range as {
+				if as[j].String() == valid_out[i] {
+					a = &as[j]
+				}
+			}
+			expected := variants[i]
+			if policy == Descriptive {
+				expected = nil
+			}
+			if a == nil {
+				t.Errorf("AnalyzeWritten(%s) = %v, expected to contain %s", w, as, valid_out[i])
+			} else if (a.Variant == nil) != (expected == nil) || (a.Variant != nil && *a.Variant != *expected) {
+				t.Errorf("AnalyzeWritten(%s) with policy %d variant = %+v, expected %+v", w, policy, a.Variant, expected)
+			}
+		}
+	}
+	/* a foreign plural starts from FOREIGNPL.ROOT, which derives no verbs: evraklar is not evrak+V.N.LA+TAM.AOR.A */
+	for _, a := range an.Analyze("evraklar") {
+		if len(a.Tags) != 0 && derivational(a.Tags[0]) {
+			t.Errorf("Analyze(evraklar) = %v, expected no derivation", a)
+		}
+	}
+}
+
+/* a hyphen or space that is not a reduplication is dropped, or only the last word is analyzed */
+func TestAnalyzeWrittenSeparated(t *testing.T) {
+	an := load(t)
+	valid := []string{"Ankara-da", "kita-pta", "buz-dolabı", "koşa koşa koşa", "yapa-yapma", "x evde"}
+	valid_out := []string{
+		"ankara[NOUN]+CASE.LOC",
+		"kitap[NOUN]+CASE.LOC",
+		"dolap[NOUN]+POS.3sg+CASE.ABSL",
+		"koş[VERB]+CVB.V.1",
+		"yap[VERB]+NEG.NEG+IMP.2sg",
+		"ev[NOUN]+CASE.LOC",
+	}
+	variants := []Variant{
+		{Standard: "Ankara'da", Note: NoteMissingApostrophe + "; " + NoteJoined},
+		{Standard: "kitapta", Note: NoteJoined},
+		{Standard: "buz dolabı", Note: NotePhrase},
+		{Standard: "koşa koşa koşa", Note: NotePhrase},
+		{Standard: "yapa yapma", Note: NotePhrase},
+		{Standard: "x evde", Note: NotePhrase},
+	}
+	for i, w := range valid {
+		var a *Analysis
+		as := an.AnalyzeWritten(w)
+		for j := range as {
+			if as[j].String() == valid_out[i] {
+				a = &as[j]
+			}
+		}
+		if a == nil {
+			t.Errorf("AnalyzeWritten(%s) = %v, expected to contain %s", w, as, valid_out[i])
+		} else if a.Variant == nil || *a.Variant != variants[i] {
+			t.Errorf("AnalyzeWritten(%s) variant = %+v, expected %+v", w, a.Variant, variants[i])
+		}
+	}
+
+	/* only hyphens between letters separate words, so a negative number is not analyzed as the number joined */
+	for _, w := range []string{"-3", "-3'te", "3-4", "-ev"} {
+		if as := an.AnalyzeWritten(w); len(as) != 0 {
+			t.Errorf("AnalyzeWritten(%s) = %v, expected no analyses", w, as)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+)
+
+/* returns the states of the morphotactics in sorted order */
+func (m *Morphotactics) States() []string {
+	var states []string
+	for s := range m.next {
+		states = append(states, s)
+	}
+	for s := range m.final {
+		if _, ok := m.next[s]; !ok {
+			states = append(states, s)
+		}
+	}
+	sort.Strings(states)
+	return states
+}
+
+/*
+the suffixes of the catalog that are deliberately not in the morphotactics: the head marker of noun compounds,
+which inflection.Compound appends, and the interrogative particle, which is written separately and analyzed
+as the QUES roots mi, mı, mu, mü of the lexicon
+*/
+var unordered = map[string]bool{"HD": true, "INT": true}
+
+/*
+Checks that the catalog, morphotactics and lexicon of the analyzer fit together and returns the problems found:
+a part of speech of the lexicon without a root state, a root listed twice, a suffix that no word can contain
+(except the unordered suffixes HD and INT) and a state after which no word can end. Data that loads without
+errors can still have these problems, which leave words unanalyzed.
+*/
+func (an *Analyzer) Verify() []error {
+	var errs []error
+
+	/* the states reachable from the root states of the lexicon */
+	reached := map[string]bool{}
+	var queue []string
+	seen := map[string]bool{}
+	for _, e := range an.Lexicon.Entries {
+		k := e.Root.String() + " " + e.POS
+		if seen[k] {
+			errs = append(errs, fmt.Errorf("lexicon: %s %s listed twice", e.Root, e.POS))
+		}
+		seen[k] = true
+		root := an.Tactics.Root(e)
+		if reached[root] {
+			continue
+		}
+		if len(an.Tactics.Next(root)) == 0 && !an.Tactics.Final(root) {
+			errs = append(errs, fmt.Errorf("lexicon: %s: no state %s in the morphotactics", e.Root, root))
+		}
+		reached[root] = true
+		queue = append(queue, root)
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for _, tag := range an.Tactics.Next(state) {
+			if !reached[tag] {
+				reached[tag] = true
+				queue = append(queue, tag)
+			}
+		}
+	}
+	for _, tag := range an.Catalog.Tags() {
+		if !reached[tag] && !unordered[tag] {
+			errs = append(errs, fmt.Errorf("morphotactics: suffix %s cannot follow a root", tag))
+		}
+	}
+
+	/* the states from which a word can end, found backwards from the final states */
+	prev := map[string][]string{}
+	ends := map[string]bool{}
+	for _, s := range an.Tactics.States() {
+		for _, tag := range an.Tactics.Next(s) {
+			prev[tag] = append(prev[tag], s)
+		}
+		if an.Tactics.Final(s) {
+			ends[s] = true
+			queue = append(queue, s)
+		}
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for _, s := range prev[state] {
+			if !ends[s] {
+				ends[s] = true
+				queue = append(queue, s)
+			}
+		}
+	}
+	for _, s := range an.Tactics.States() {
+		if reached[s] && !ends[s] {
+			errs = append(errs, fmt.Errorf("morphotactics: no word can end after %s", s))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,55 @@
+package analysis
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	c, _ := DecodeCatalog(strings.NewReader(`
+PL = "lAr"
+INT = "mI"
+GER = "mA"
+[CASE]
+  ABSL = ""
+  DAT = "(y)A"
+[POS]
+  1sg = "(I)m"
+`))
+	m, _ := DecodeMorphotactics(strings.NewReader(`
+NOUN.ROOT
+	PL
+	CASE
+PL
+	POS
+POS.1sg # no word can end after POS.1sg
+	POS
+CASE
+	END
+`), c)
+	lex, _ := DecodeLexicon(strings.NewReader(`
+ev NOUN
+ev NOUN
+gel VERB
+`))
+	errs := New(c, m, lex).Verify()
+	var msgs []string
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+	valid_out := []string{
+		"lexicon: ev NOUN listed twice",
+		"lexicon: gel: no state VERB.ROOT in the morphotactics",
+		"morphotactics: suffix GER cannot follow a root", /* but not INT, which is unordered */
+		"morphotactics: no word can end after PL",
+		"morphotactics: no word can end after POS.1sg",
+	}
+	if !reflect.DeepEqual(msgs, valid_out) {
+		t.Errorf("Verify() = %q, expected %q", msgs, valid_out)
+	}
+
+	if errs := load(t).Verify(); len(errs) != 0 {
+		t.Errorf("Verify() of the data files = %v, expected no problems", errs)
+	}
+}
@@ -0,0 +1,93 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+/*
+Weights score analyses by corpus frequencies (see Stats): the log probability of the root and of each
+transition of its suffix chain given the previous state, with add-one smoothing so that roots and
+transitions missing from the corpus are unlikely rather than impossible.
+*/
+type Weights struct {
+	Stats *Stats
+
+	roots float64            /* total count of roots */
+	from  map[string]float64 /* total count of transitions from each state */
+	succ  map[string]float64 /* number of distinct transitions from each state */
+}
+
+/* creates weights from stats, e.g. those written by the stats command and read by LoadStats */
+func NewWeights(st *Stats) *Weights {
+	wt := &Weights{Stats: st, from: map[string]float64{}, succ: map[string]float64{}}
+	for _, c := range st.Roots {
+		wt.roots += c
+	}
+	for k, c := range st.Transitions {
+		if i := strings.Index(k, ">"); i >= 0 {
+			wt.from[k[:i]] += c
+			wt.succ[k[:i]]++
+		}
+	}
+	return wt
+}
+
+/* reads weights from a stats file written by Stats.Write */
+func LoadWeights(path string) (*Weights, error) {
+	st, err := LoadStats(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewWeights(st), nil
+}
+
+/* returns the log probability of an analysis: of its root and of every transition up to END */
+func (wt *Weights) Score(a Analysis) float64 {
+	s := math.Log((wt.Stats.Roots[RootKey(a)] + 1) / (wt.roots + float64(len(wt.Stats.Roots)) + 1))
+	prev := a.POS + ".ROOT"
+	for _, tag := range append(append([]string(nil), a.Tags...), End) {
+		s += math.Log((wt.Stats.Transitions[prev+">"+tag] + 1) / (wt.from[prev] + wt.succ[prev] + 1))
+		prev = tag
+	}
+	return s
+}
+
+/* A Scored analysis has a score, higher is better (see Analyzer.Rank) */
+type Scored struct {
+	Analysis
+	Score float64
+}
+
+/*
+Scores analyses and orders them best first, keeping the order of equal scores. With Weights the score is
+Weights.Score, otherwise the negated number of suffixes so that the simplest analysis is best. The Penalty
+of an analysis is subtracted from its score.
+*/
+func (an *Analyzer) Rank(as []Analysis) []Scored {
+	ss := make([]Scored, len(as))
+	for i, a := range as {
+		ss[i] = Scored{Analysis: a, Score: -float64(len(a.Tags))}
+		if an.Weights != nil {
+			ss[i].Score = an.Weights.Score(a)
+		}
+		ss[i].Score -= a.Penalty
+	}
+	sort.SliceStable(ss, func(i, j int) bool { return ss[i].Score > ss[j].Score })
+	return ss
+}
+
+/* returns the ranked analyses of a word (see Analyze and Rank) */
+func (an *Analyzer) Ranked(word string) []Scored {
+	return an.Rank(an.Analyze(word))
+}
+
+/* returns the best analysis of a word (see Analyze), and false if it has none */
+func (an *Analyzer) Best(word string) (Analysis, bool) {
+	ss := an.Ranked(word)
+	if len(ss) == 0 {
+		return Analysis{}, false
+	}
+	return ss[0].Analysis, true
+}
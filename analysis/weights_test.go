@@ -0,0 +1,66 @@
+package analysis
+
+import (
+	"strings"
+	"testing"
+)
+
+/* a corpus in which evi is mostly the possessive and öğretmen the gerund of öğret */
+const weights_stats = `# tokens 20 analyzed 20
+root	ev[NOUN]	10
+root	öğret[VERB]	10
+transition	NOUN.ROOT>POS.3sg	9
+transition	NOUN.ROOT>CASE.ACC	1
+transition	POS.3sg>CASE.ABSL	9
+transition	CASE.ACC>END	1
+transition	CASE.ABSL>END	19
+transition	VERB.ROOT>GER	10
+transition	GER>POS.2sg	10
+transition	POS.2sg>CASE.ABSL	10
+`
+
+func TestRank(t *testing.T) {
+	an := load(t)
+	words := []string{"evi", "öğretmen"}
+	/* the best analysis without and with weights */
+	plain := []string{"ev[NOUN]+CASE.ACC", "öğretmen[NOUN]+CASE.ABSL"}
+	weighted := []string{"ev[NOUN]+POS.3sg+CASE.ABSL", "öğret[VERB]+GER+POS.2sg+CASE.ABSL"}
+
+	for i, w := range words {
+		if a, ok := an.Best(w); !ok || a.String() != plain[i] {
+			t.Errorf("Best(%s) = %v, %v, expected %s", w, a, ok, plain[i])
+		}
+	}
+
+	st, err := ReadStats(strings.NewReader(weights_stats))
+	if err != nil {
+		t.Fatalf("ReadStats() error: %v", err)
+	}
+	an.Weights = NewWeights(st)
+	for i, w := range words {
+		ss := an.Ranked(w)
+		if len(ss) != len(an.Analyze(w)) || ss[0].String() != weighted[i] {
+			t.Errorf("Ranked(%s) = %v, expected %s first", w, ss, weighted[i])
+		}
+		for j := 1; j < len(ss); j++ {
+			if ss[j].Score > ss[j-1].Score {
+				t.Errorf("Ranked(%s) = %v, expected decreasing scores", w, ss)
+			}
+		}
+	}
+
+	/* the penalty of an analysis lowers its score */
+	as := an.Analyze("evi")
+	for i := range as {
+		if as[i].String() == weighted[0] {
+			as[i].Penalty = GuessPenalty * 10
+		}
+	}
+	if ss := an.Rank(as); ss[0].String() == weighted[0] {
+		t.Errorf("Rank(%v) = %v, expected the penalized analysis last", as, ss)
+	}
+
+	if a, ok := an.Best("xyz"); ok {
+		t.Errorf("Best(xyz) = %v, expected no analysis", a)
+	}
+}
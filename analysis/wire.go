@@ -0,0 +1,296 @@
+package analysis
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"unicode/utf8"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/*
+The wire format is a compact binary encoding of the analyses of words, for programs that exchange many of
+them. Each message holds the analyses of one word:
+
+	count                        uvarint
+	for each analysis:
+		flags                    byte: wireGuessed, wireVariant, wirePenalty, wireStems
+		root, POS                string references
+		flags, tags              uvarint count, string references
+		word                     string
+		penalty                  8 bytes, little endian float64 (if wirePenalty)
+		standard, note           string, string reference (if wireVariant)
+		stems                    uvarint count, strings (if wireStems)
+
+A string is its uvarint length and its UTF-8 bytes, without escapes. A string reference is 0 followed by a
+string, which is then added to a table of strings shared by the encoder and the decoder (up to MaxWireStrings),
+or the index in the table plus 1, so the roots, parts of speech and tags repeated in every message are numbers.
+*/
+
+/* the bits of the flags of an analysis in the wire format */
+const (
+	wireGuessed = 1 << iota
+	wireVariant
+	wirePenalty
+	wireStems
+)
+
+/* the most strings of the table of an encoder or decoder; later strings are always written in full */
+const MaxWireStrings = 1 << 16
+
+/* the largest count or string length a decoder accepts */
+const maxWireLen = 1 << 16
+
+var errWire = errors.New("analysis: invalid wire format")
+
+/* An Encoder writes analyses in the wire format. Stems are only written if Stems is set */
+type Encoder struct {
+	Stems bool
+
+	w       *bufio.Writer
+	strings map[string]uint64
+	buf     [binary.MaxVarintLen64]byte
+}
+
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w), strings: map[string]uint64{}}
+}
+
+/* writes the analyses of one word as a message */
+func (e *Encoder) Encode(as []Analysis) error {
+	e.uvarint(uint64(len(as)))
+	for _, a := range as {
+		var flags byte
+		if a.Guessed {
+			flags |= wireGuessed
+		}
+		if a.Variant != nil {
+			flags |= wireVariant
+		}
+		if a.Penalty != 0 {
+			flags |= wirePenalty
+		}
+		if e.Stems {
+			flags |= wireStems
+		}
+		e.w.WriteByte(flags)
+		e.ref(string(a.Root))
+		e.ref(a.POS)
+		e.uvarint(uint64(len(a.Flags)))
+		for _, f := range a.Flags {
+			e.ref(f)
+		}
+		e.uvarint(uint64(len(a.Tags)))
+		for _, tag := range a.Tags {
+			e.ref(tag)
+		}
+		e.runes(a.Word)
+		if flags&wirePenalty != 0 {
+			var b [8]byte
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(a.Penalty))
+			e.w.Write(b[:])
+		}
+		if flags&wireVariant != 0 {
+			e.string(a.Variant.Standard)
+			e.ref(a.Variant.Note)
+		}
+		if flags&wireStems != 0 {
+			e.uvarint(uint64(len(a.Stems)))
+			for _, s := range a.Stems {
+				e.runes(s)
+			}
+		}
+	}
+	return e.w.Flush()
+}
+
+func (e *Encoder) uvarint(x uint64) {
+	e.w.Write(e.buf[:binary.PutUvarint(e.buf[:], x)])
+}
+
+func (e *Encoder) string(s string) {
+	e.uvarint(uint64(len(s)))
+	e.w.WriteString(s)
+}
+
+/* writes runes as a string without converting them */
+func (e *Encoder) runes(rs []rune) {
+	n := 0
+	for _, r := range rs {
+		if l := utf8.RuneLen(r); l > 0 {
+			n += l
+		} else {
+			n += utf8.RuneLen(utf8.RuneError) /* written by WriteRune instead of an invalid rune */
+		}
+	}
+	e.uvarint(uint64(n))
+	for _, r := range rs {
+		e.w.WriteRune(r)
+	}
+}
+
+func (e *Encoder) ref(s string) {
+	if i, ok := e.strings[s]; ok {
+		e.uvarint(i + 1)
+		return
+	}
+	e.uvarint(0)
+	e.string(s)
+	if len(e.strings) < MaxWireStrings {
+		e.strings[s] = uint64(len(e.strings))
+	}
+}
+
+/*
+A Decoder reads analyses written by an Encoder. The analyses have no Stems unless the encoder wrote them.
+Counts and lengths over maxWireLen are rejected as invalid, so corrupt input cannot cause huge allocations.
+*/
+type Decoder struct {
+	r       *bufio.Reader
+	strings []string
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: bufio.NewReader(r)}
+}
+
+/* reads the analyses of one word, returning io.EOF at the end of the stream */
+func (d *Decoder) Decode() ([]Analysis, error) {
+	n, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxWireLen {
+		return nil, errWire
+	}
+	as := make([]Analysis, n)
+	for i := range as {
+		if err := d.analysis(&as[i]); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+	}
+	return as, nil
+}
+
+func (d *Decoder) analysis(a *Analysis) error {
+	flags, err := d.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	a.Guessed = flags&wireGuessed != 0
+	root, err := d.ref()
+	if err != nil {
+		return err
+	}
+	a.Root = inf.Root(root)
+	if a.POS, err = d.ref(); err != nil {
+		return err
+	}
+	if a.Flags, err = d.refs(); err != nil {
+		return err
+	}
+	if a.Tags, err = d.refs(); err != nil {
+		return err
+	}
+	word, err := d.string()
+	if err != nil {
+		return err
+	}
+	a.Word = inf.Word(word)
+	if flags&wirePenalty != 0 {
+		var b [8]byte
+		if _, err := io.ReadFull(d.r, b[:]); err != nil {
+			return err
+		}
+		a.Penalty = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+	}
+	if flags&wireVariant != 0 {
+		a.Variant = &Variant{}
+		if a.Variant.Standard, err = d.string(); err != nil {
+			return err
+		}
+		if a.Variant.Note, err = d.ref(); err != nil {
+			return err
+		}
+	}
+	if flags&wireStems != 0 {
+		n, err := d.count()
+		if err != nil {
+			return err
+		}
+		a.Stems = make([]inf.Stem, n)
+		for i := range a.Stems {
+			s, err := d.string()
+			if err != nil {
+				return err
+			}
+			a.Stems[i] = inf.Stem(s)
+		}
+	}
+	return nil
+}
+
+/* reads a count or length */
+func (d *Decoder) count() (int, error) {
+	n, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		return 0, err
+	}
+	if n > maxWireLen {
+		return 0, errWire
+	}
+	return int(n), nil
+}
+
+func (d *Decoder) string() (string, error) {
+	n, err := d.count()
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(d.r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (d *Decoder) ref() (string, error) {
+	i, err := binary.ReadUvarint(d.r)
+	if err != nil {
+		return "", err
+	}
+	if i > 0 {
+		if i > uint64(len(d.strings)) {
+			return "", errWire
+		}
+		return d.strings[i-1], nil
+	}
+	s, err := d.string()
+	if err != nil {
+		return "", err
+	}
+	if len(d.strings) < MaxWireStrings {
+		d.strings = append(d.strings, s)
+	}
+	return s, nil
+}
+
+func (d *Decoder) refs() ([]string, error) {
+	n, err := d.count()
+	if err != nil || n == 0 {
+		return nil, err
+	}
+	ss := make([]string, n)
+	for i := range ss {
+		if ss[i], err = d.ref(); err != nil {
+			return nil, err
+		}
+	}
+	return ss, nil
+}
@@ -0,0 +1,172 @@
+package analysis
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+	"testing"
+)
+
+/* the analyses of the golden words and of words with variants and guessed roots */
+func wireAnalyses(t testing.TB) [][]Analysis {
+	an := load(t)
+	var res [][]Analysis
+	for _, w := range goldenWords(t) {
+		res = append(res, an.Analyze(w))
+	}
+	res = append(res, an.AnalyzeWritten("Ankarada"), an.AnalyzeWritten("yapa-yapa"), nil)
+	an.Options.GuessRoots = true
+	res = append(res, an.Analyze("zoomdayım"))
+	return res
+}
+
+/* returns the analyses as a decoder returns them: without stems if stems is false and with nil empty lists */
+func decoded(as []Analysis, stems bool) []Analysis {
+	res := make([]Analysis, len(as))
+	for i, a := range as {
+		if len(a.Flags) == 0 {
+			a.Flags = nil
+		}
+		if len(a.Tags) == 0 {
+			a.Tags = nil
+		}
+		if !stems {
+			a.Stems = nil
+		}
+		res[i] = a
+	}
+	return res
+}
+
+func TestWire(t *testing.T) {
+	words := wireAnalyses(t)
+	for _, stems := range []bool{false, true} {
+		var buf bytes.Buffer
+		e := NewEncoder(&buf)
+		e.Stems = stems
+		for _, as := range words {
+			if err := e.Encode(as); err != nil {
+				t.Fatalf("Encode() error: %v", err)
+			}
+		}
+		d := NewDecoder(&buf)
+		for _, as := range words {
+			out, err := d.Decode()
+			if err != nil {
+				t.Fatalf("Decode() error: %v", err)
+			}
+			if expected := decoded(as, stems); !reflect.DeepEqual(out, expected) {
+				t.Fatalf("Decode() = %v, expected %v", out, expected)
+			}
+		}
+		if _, err := d.Decode(); err != io.EOF {
+			t.Errorf("Decode() at the end = %v, expected io.EOF", err)
+		}
+	}
+}
+
+/* every truncation and corruption of a stream is an error and not a panic */
+func TestWireInvalid(t *testing.T) {
+	an := load(t)
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	e.Stems = true
+	e.Encode(an.AnalyzeWritten("Ankarada"))
+	e.Encode(an.Analyze("evlerimizden"))
+	b := buf.Bytes()
+	for i := 0; i < len(b); i++ {
+		d := NewDecoder(bytes.NewReader(b[:i]))
+		k := 0
+		for ; k < 2; k++ {
+			if _, err := d.Decode(); err != nil {
+				break
+			}
+		}
+		if k == 2 {
+			t.Errorf("Decode() of the first %d of %d bytes decoded both messages", i, len(b))
+		}
+	}
+	for i := range b {
+		c := append([]byte(nil), b...)
+		c[i] ^= 0xff
+		d := NewDecoder(bytes.NewReader(c))
+		for k := 0; k < 2; k++ {
+			if _, err := d.Decode(); err != nil {
+				break
+			}
+		}
+	}
+	if _, err := NewDecoder(bytes.NewReader([]byte{1, 0, 5})).Decode(); err != errWire {
+		t.Errorf("Decode() of a reference outside the table = %v, expected %v", err, errWire)
+	}
+}
+
+func BenchmarkWireEncode(b *testing.B) {
+	words := wireAnalyses(b)
+	var buf bytes.Buffer
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		buf.Reset()
+		e := NewEncoder(&buf)
+		for _, as := range words {
+			e.Encode(as)
+		}
+	}
+	b.ReportMetric(float64(buf.Len()), "bytes")
+}
+
+func BenchmarkWireDecode(b *testing.B) {
+	words := wireAnalyses(b)
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	for _, as := range words {
+		e.Encode(as)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		d := NewDecoder(bytes.NewReader(buf.Bytes()))
+		for range words {
+			d.Decode()
+		}
+	}
+}
+
+/* encoding/json of the same analyses without stems, for comparison */
+func BenchmarkJSONEncode(b *testing.B) {
+	var words [][]Analysis
+	for _, as := range wireAnalyses(b) {
+		words = append(words, decoded(as, false))
+	}
+	var buf bytes.Buffer
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		buf.Reset()
+		e := json.NewEncoder(&buf)
+		for _, as := range words {
+			e.Encode(as)
+		}
+	}
+	b.ReportMetric(float64(buf.Len()), "bytes")
+}
+
+func BenchmarkJSONDecode(b *testing.B) {
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	words := wireAnalyses(b)
+	for _, as := range words {
+		e.Encode(decoded(as, false))
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		d := json.NewDecoder(bytes.NewReader(buf.Bytes()))
+		for range words {
+			var as []Analysis
+			d.Decode(&as)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+	"github.com/kaan9/turkish-morphology/corpus"
+	inf "github.com/kaan9/turkish-morphology/inflection"
+	"github.com/kaan9/turkish-morphology/tokenize"
+)
+
+/*
+processes the lines of the files (or stdin) on the workers of the driver and calls emit with the results in the
+order of the lines, holding only the window of the driver in memory (see corpus.Driver)
+*/
+func eachLine(d corpus.Driver, files []string, worker func() func(line string) interface{}, emit func(interface{}) error) error {
+	return eachInput(files, func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		err := d.Run(func() (interface{}, bool) {
+			if !scanner.Scan() {
+				return nil, false
+			}
+			return scanner.Text(), true
+		}, func() func(interface{}) interface{} {
+			work := worker()
+			return func(line interface{}) interface{} {
+				return work(line.(string))
+			}
+		}, emit)
+		if err != nil {
+			return err
+		}
+		return scanner.Err()
+	})
+}
+
+/*
+inflect [-j N] [FILE...]
+Reads a root and suffixes per line of the files (or stdin) and prints the inflected words in order,
+using N workers (see corpus.Driver)
+*/
+func inflectCmd(args []string) {
+	fs := flag.NewFlagSet("inflect", flag.ExitOnError)
+	j := fs.Int("j", 0, "number of parallel workers (0 uses all processors)")
+	fs.Parse(args)
+
+	type inflected struct {
+		line string
+		word inf.Word
+		ok   bool
+	}
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	n := 0
+	err := eachLine(corpus.Driver{Workers: *j}, fs.Args(), func() func(string) interface{} {
+		b := &inf.Builder{}
+		return func(line string) interface{} {
+			root, sufs, ok := inf.ParseRootSuffixes(line)
+			if !ok {
+				return inflected{line: line}
+			}
+			b.Reset(root)
+			for _, suf := range sufs {
+				b.Append(suf)
+			}
+			return inflected{line, b.AppendWord(nil), true}
+		}
+	}, func(res interface{}) error {
+		n++
+		r := res.(inflected)
+		if !r.ok {
+			return fmt.Errorf("line %d: failed to parse %q", n, r.line)
+		}
+		fmt.Fprintln(out, r.word)
+		return nil
+	})
+	if err != nil {
+		out.Flush()
+		fatal(err)
+	}
+}
+
+/*
+analyze [-data DIR] [-j N] [FILE...]
+Analyzes every word of the files (or stdin) and prints each word followed by its analyses, separated by tabs,
+using N workers (see corpus.Driver)
+*/
+func analyzeCmd(args []string) {
+	fs := flag.NewFlagSet("analyze", flag.ExitOnError)
+	data := fs.String("data", ".", "directory of the data files")
+	j := fs.Int("j", 0, "number of parallel workers (0 uses all processors)")
+	fs.Parse(args)
+
+	an, err := analysis.Load(*data)
+	if err != nil {
+		fatal(err)
+	}
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	err = eachLine(corpus.Driver{Workers: *j}, fs.Args(), func() func(string) interface{} {
+		return func(line string) interface{} {
+			var b strings.Builder
+			for _, w := range tokenize.Words(line) {
+				b.WriteString(w)
+				for _, a := range an.Analyze(w) {
+					b.WriteString("\t" + a.String())
+				}
+				b.WriteString("\n")
+			}
+			return b.String()
+		}
+	}, func(res interface{}) error {
+		_, err := out.WriteString(res.(string))
+		return err
+	})
+	if err != nil {
+		out.Flush()
+		fatal(err)
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+)
+
+/*
+catalog [-data DIR] [-pos POS] [-category all|inflectional|derivational] [PREFIX...]
+Prints the tags of the suffix catalog and their suffixes, one per line: those a word of POS may take if -pos
+is given (see Analyzer.ForPOS), of a category, and matching one of the prefixes if any are given:
+
+	catalog -pos VERB TAM     the tenses of a verb
+	catalog -category derivational
+*/
+func catalogCmd(args []string) {
+	fs := flag.NewFlagSet("catalog", flag.ExitOnError)
+	data := fs.String("data", ".", "directory of the data files")
+	pos := fs.String("pos", "", "only list the suffixes a word of this part of speech may take")
+	category := fs.String("category", "all", "the category of the suffixes: all, inflectional or derivational")
+	fs.Parse(args)
+	categories := map[string][]analysis.Category{
+		"all":          {analysis.Inflectional, analysis.Derivational},
+		"inflectional": {analysis.Inflectional},
+		"derivational": {analysis.Derivational},
+	}
+	cats, ok := categories[*category]
+	if !ok {
+		fatal(fmt.Errorf("unknown category %q", *category))
+	}
+
+	an, err := analysis.Load(*data)
+	if err != nil {
+		fatal(err)
+	}
+	in := map[string]bool{}
+	for _, cat := range cats {
+		for _, tag := range an.Catalog.ByCategory(cat) {
+			in[tag] = true
+		}
+	}
+	tags := an.Catalog.Tags()
+	if *pos != "" {
+		tags = an.ForPOS(*pos)
+	}
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	for _, tag := range tags {
+		if !in[tag] || !matches(an.Catalog, tag, fs.Args()) {
+			continue
+		}
+		suf, _ := an.Catalog.Suffix(tag)
+		fmt.Fprintf(out, "%s\t%s\n", tag, suf)
+	}
+}
+
+/* reports whether a tag matches one of the prefixes (see Catalog.Match), or there are none */
+func matches(c *analysis.Catalog, tag string, prefixes []string) bool {
+	for _, p := range prefixes {
+		for _, m := range c.Match(p) {
+			if m == tag {
+				return true
+			}
+		}
+	}
+	return len(prefixes) == 0
+}
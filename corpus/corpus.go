@@ -0,0 +1,132 @@
+package corpus
+
+import (
+	"runtime"
+)
+
+/*
+A Driver processes a stream of items (tokens, lines, ...) on parallel workers and delivers the results in the
+order of the items. At most Window items are held at once, counting the items being processed and the results
+waiting for an earlier item to finish, so a slow consumer stops the reading of new items (backpressure) and
+memory is bounded however long the stream is.
+*/
+type Driver struct {
+	Workers int /* runtime.GOMAXPROCS if <= 0 */
+	Window  int /* 4 * Workers if <= 0 */
+}
+
+/* the default driver, with all processors and the default window */
+var Default = Driver{}
+
+type job struct {
+	seq  int
+	item interface{}
+}
+
+/*
+Reads items with next until it returns false and processes each with a worker, calling emit with the results
+in the order of the items. worker is called once per worker goroutine and returns the function processing its
+items, so that a worker can keep state (a buffer, an inflection.Builder) between items. next and emit are only
+called from one goroutine at a time. Stops reading at the first error of emit and returns it.
+*/
+func (d Driver) Run(next func() (interface{}, bool), worker func() func(interface{}) interface{}, emit func(interface{}) error) error {
+	workers, window := d.Workers, d.Window
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if window <= 0 {
+		window = 4 * workers
+	}
+	if window < workers {
+		workers = window
+	}
+
+	jobs := make(chan job)
+	results := make(chan job, window)
+	tokens := make(chan struct{}, window) /* one for each item held */
+	done := make(chan struct{})
+
+	go func() {
+		defer close(jobs)
+		for seq := 0; ; seq++ {
+			select {
+			case tokens <- struct{}{}:
+			case <-done:
+				return
+			}
+			item, ok := next()
+			if !ok {
+				return
+			}
+			select {
+			case jobs <- job{seq, item}:
+			case <-done:
+				return
+			}
+		}
+	}()
+	finished := make(chan struct{})
+	for k := 0; k < workers; k++ {
+		go func() {
+			defer func() { finished <- struct{}{} }()
+			work := worker()
+			for j := range jobs {
+				results <- job{j.seq, work(j.item)} /* never blocks: results holds a whole window */
+			}
+		}()
+	}
+	go func() {
+		for k := 0; k < workers; k++ {
+			<-finished
+		}
+		close(results)
+	}()
+
+	pending := make(map[int]interface{}, window)
+	want := 0
+	var err error
+	for r := range results {
+		if err != nil {
+			continue /* drain the workers */
+		}
+		pending[r.seq] = r.item
+		for {
+			res, ok := pending[want]
+			if !ok {
+				break
+			}
+			delete(pending, want)
+			want++
+			if err = emit(res); err != nil {
+				close(done)
+				break
+			}
+			<-tokens
+		}
+	}
+	return err
+}
+
+/*
+Processes the indices 0 to n-1 on workers goroutines (runtime.GOMAXPROCS if workers <= 0), for the items of a
+slice whose results are stored at their index. worker is called once per goroutine as in Run.
+*/
+func Each(n, workers int, worker func() func(i int)) {
+	d := Driver{Workers: workers}
+	i := 0
+	d.Run(func() (interface{}, bool) {
+		if i == n {
+			return nil, false
+		}
+		i++
+		return i - 1, true
+	}, func() func(interface{}) interface{} {
+		work := worker()
+		return func(item interface{}) interface{} {
+			work(item.(int))
+			return nil
+		}
+	}, func(interface{}) error {
+		return nil
+	})
+}
@@ -0,0 +1,113 @@
+package corpus
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+)
+
+/* returns a source of the integers 0 to n-1 */
+func count(n int) func() (interface{}, bool) {
+	i := 0
+	return func() (interface{}, bool) {
+		if i == n {
+			return nil, false
+		}
+		i++
+		return i - 1, true
+	}
+}
+
+/* a worker that squares integers after a random delay, so that the results finish out of order */
+func square() func(interface{}) interface{} {
+	r := rand.New(rand.NewSource(rand.Int63()))
+	return func(item interface{}) interface{} {
+		time.Sleep(time.Duration(r.Intn(100)) * time.Microsecond)
+		return item.(int) * item.(int)
+	}
+}
+
+func TestRun(t *testing.T) {
+	for _, d := range []Driver{Default, {Workers: 1}, {Workers: 8}, {Workers: 8, Window: 2}, {Workers: 3, Window: 100}} {
+		var out []int
+		err := d.Run(count(500), square, func(res interface{}) error {
+			out = append(out, res.(int))
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("%+v.Run() error: %v", d, err)
+		}
+		if len(out) != 500 {
+			t.Fatalf("%+v.Run() emitted %d results, expected 500", d, len(out))
+		}
+		for i, x := range out {
+			if x != i*i {
+				t.Fatalf("%+v.Run() result %d = %d, expected %d", d, i, x, i*i)
+			}
+		}
+	}
+}
+
+/* the items read but not yet emitted never exceed the window */
+func TestRunWindow(t *testing.T) {
+	d := Driver{Workers: 4, Window: 6}
+	next := count(1000)
+	read, emitted := 0, 0
+	var mu sync.Mutex
+	err := d.Run(func() (interface{}, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		if read-emitted >= d.Window {
+			t.Errorf("read %d items with %d emitted, expected at most a window of %d", read+1, emitted, d.Window)
+		}
+		read++
+		return next()
+	}, square, func(interface{}) error {
+		time.Sleep(10 * time.Microsecond) /* a slow consumer */
+		mu.Lock()
+		emitted++
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Run() error: %v", err)
+	}
+}
+
+func TestRunError(t *testing.T) {
+	stop := errors.New("stop")
+	read, emitted := 0, 0
+	next := count(1 << 20)
+	err := Driver{Workers: 4, Window: 8}.Run(func() (interface{}, bool) {
+		read++
+		return next()
+	}, square, func(res interface{}) error {
+		emitted++
+		if emitted == 10 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Fatalf("Run() error = %v, expected %v", err, stop)
+	}
+	if emitted != 10 || read > 10+8 {
+		t.Fatalf("Run() emitted %d and read %d items after an error at 10, expected to stop reading", emitted, read)
+	}
+}
+
+func TestEach(t *testing.T) {
+	res := make([]int, 1000)
+	Each(len(res), 0, func() func(int) {
+		return func(i int) {
+			res[i] = i + 1
+		}
+	})
+	for i, x := range res {
+		if x != i+1 {
+			t.Fatalf("Each() stored %d at %d, expected %d", x, i, i+1)
+		}
+	}
+}
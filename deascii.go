@@ -0,0 +1,41 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+	"github.com/kaan9/turkish-morphology/deascii"
+)
+
+/*
+deascii [-data DIR] [FILE...]
+Copies the files (or stdin) to stdout restoring the Turkish letters of text typed in ASCII
+*/
+func deasciiCmd(args []string) {
+	fs := flag.NewFlagSet("deascii", flag.ExitOnError)
+	data := fs.String("data", ".", "directory of the data files")
+	fs.Parse(args)
+
+	an, err := analysis.Load(*data)
+	if err != nil {
+		fatal(err)
+	}
+	d := deascii.New(an)
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	err = eachInput(fs.Args(), func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			fmt.Fprintln(out, d.Text(scanner.Text()))
+		}
+		return scanner.Err()
+	})
+	if err != nil {
+		out.Flush()
+		fatal(err)
+	}
+}
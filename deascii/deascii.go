@@ -0,0 +1,118 @@
+package deascii
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+	"github.com/kaan9/turkish-morphology/normalize"
+	"github.com/kaan9/turkish-morphology/tokenize"
+)
+
+/* the Turkish letters that are typed as ASCII letters without a Turkish keyboard */
+var ascii = map[rune]rune{
+	'ç': 'c', 'ğ': 'g', 'ı': 'i', 'ö': 'o', 'ş': 's', 'ü': 'u',
+	'Ç': 'C', 'Ğ': 'G', 'İ': 'I', 'Ö': 'O', 'Ş': 'S', 'Ü': 'U',
+}
+
+/* returns the ASCII letter a Turkish letter is typed as, or the letter itself */
+func Fold(r rune) rune {
+	if a, ok := ascii[r]; ok {
+		return a
+	}
+	return r
+}
+
+/* returns s typed in ASCII: çalışıyorum -> calisiyorum */
+func ToASCII(s string) string {
+	return strings.Map(Fold, s)
+}
+
+/*
+A Deasciifier restores the Turkish letters of text typed in ASCII (calisiyorum -> çalışıyorum) by choosing,
+among the writings that differ in i/ı, s/ş, c/ç, g/ğ, o/ö and u/ü, those that the analyzer can analyze
+*/
+type Deasciifier struct {
+	Analyzer *analysis.Analyzer
+}
+
+/* creates a Deasciifier using the analyzer */
+func New(an *analysis.Analyzer) *Deasciifier {
+	return &Deasciifier{Analyzer: an}
+}
+
+/*
+Returns the lowercase Turkish writings of a word typed in ASCII that have an analysis, best first: by the
+smallest Penalty and then the fewest suffixes of their analyses, and then in the order of the analyzer.
+*/
+func (d *Deasciifier) Candidates(word string) []string {
+	as := d.Analyzer.AnalyzeFolded(word, Fold)
+	sort.SliceStable(as, func(i, j int) bool {
+		if as[i].Penalty != as[j].Penalty {
+			return as[i].Penalty < as[j].Penalty
+		}
+		return len(as[i].Tags) < len(as[j].Tags)
+	})
+	var ws []string
+	seen := map[string]bool{}
+	for _, a := range as {
+		if w := a.Word.String(); !seen[w] {
+			seen[w] = true
+			ws = append(ws, w)
+		}
+	}
+	return ws
+}
+
+/*
+Returns the best Turkish writing of a word typed in ASCII, keeping its capitalization, apostrophes and
+hyphens: Istanbul'da -> İstanbul'da, kosa-kosa -> koşa-koşa. A word without analyses is returned unchanged.
+*/
+func (d *Deasciifier) Word(word string) string {
+	parts := strings.Split(word, "-")
+	for i, p := range parts {
+		parts[i] = d.part(p)
+	}
+	return strings.Join(parts, "-")
+}
+
+/* restores the letters of a word without hyphens, whose letters are analyzed as one word */
+func (d *Deasciifier) part(word string) string {
+	r := []rune(word)
+	var letters []rune
+	for _, c := range r {
+		if unicode.IsLetter(c) {
+			letters = append(letters, c)
+		}
+	}
+	cs := d.Candidates(string(letters))
+	if len(letters) == 0 || len(cs) == 0 {
+		return word
+	}
+	best := []rune(cs[0])
+	j := 0
+	for i, c := range r {
+		if unicode.IsLetter(c) {
+			r[i] = best[j]
+			j++
+		}
+	}
+	return normalize.Recase(word, string(r))
+}
+
+/* restores the Turkish letters of every word of running text, leaving everything else unchanged */
+func (d *Deasciifier) Text(s string) string {
+	var b strings.Builder
+	end := 0
+	for _, t := range tokenize.Tokenize(s) {
+		if t.Kind != tokenize.Word && t.Kind != tokenize.Particle {
+			continue
+		}
+		b.WriteString(s[end:t.Pos])
+		b.WriteString(d.Word(t.Text))
+		end = t.Pos + len(t.Text)
+	}
+	b.WriteString(s[end:])
+	return b.String()
+}
@@ -0,0 +1,71 @@
+package deascii
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+)
+
+func load(t *testing.T) *Deasciifier {
+	an, err := analysis.Load("..")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	return New(an)
+}
+
+func TestToASCII(t *testing.T) {
+	valid := []string{"çalışıyorum", "Güneşli", "İSTANBUL", "ağaç", "ev"}
+	valid_out := []string{"calisiyorum", "Gunesli", "ISTANBUL", "agac", "ev"}
+	for i, s := range valid {
+		if a := ToASCII(s); a != valid_out[i] {
+			t.Errorf("ToASCII(%s) = %s, expected %s", s, a, valid_out[i])
+		}
+	}
+}
+
+func TestCandidates(t *testing.T) {
+	d := load(t)
+	valid := []string{"calisiyorum", "gunesli", "ogretmenler", "mi"}
+	valid_out := [][]string{{"çalışıyorum"}, {"güneşli"}, {"öğretmenler"}, {"mi", "mı"}}
+	for i, w := range valid {
+		if cs := d.Candidates(w); !reflect.DeepEqual(cs, valid_out[i]) {
+			t.Errorf("Candidates(%s) = %v, expected %v", w, cs, valid_out[i])
+		}
+	}
+
+	if cs := d.Candidates("xyz"); len(cs) != 0 {
+		t.Errorf("Candidates(xyz) = %v, expected none", cs)
+	}
+}
+
+func TestWord(t *testing.T) {
+	d := load(t)
+	valid := []string{"calisiyorum", "Agaclar", "Istanbul'da", "ISTANBUL", "kosa-kosa", "ev", "xyz"}
+	valid_out := []string{"çalışıyorum", "Ağaçlar", "İstanbul'da", "İSTANBUL", "koşa-koşa", "ev", "xyz"}
+	for i, w := range valid {
+		if r := d.Word(w); r != valid_out[i] {
+			t.Errorf("Word(%s) = %s, expected %s", w, r, valid_out[i])
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	d := load(t)
+	valid := []string{
+		"Bugun cok calisiyorum, ogretmenler de oyle mi?",
+		"Istanbul'da gunesli. 3'te cocuk geldi...",
+		"",
+	}
+	valid_out := []string{
+		"Bugün çok çalışıyorum, öğretmenler de öyle mi?",
+		"İstanbul'da güneşli. 3'te çocuk geldi...",
+		"",
+	}
+	for i, s := range valid {
+		if r := d.Text(s); r != valid_out[i] {
+			t.Errorf("Text(%s) = %s, expected %s", s, r, valid_out[i])
+		}
+	}
+}
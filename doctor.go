@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+)
+
+/* the forms checked by doctor when no golden file is given, covering each part of speech of the lexicon */
+const samples = `
+evlerimizden ev[NOUN]+PL+POS.1pl+CASE.ABL
+kitabı kitap[NOUN]+CASE.ACC
+arabaya araba[NOUN]+CASE.DAT
+çocuğun çocuk[NOUN]+CASE.GEN
+ankarada ankara[NOUN]+CASE.LOC
+güzellik güzel[ADJ]+N.N.LIK+CASE.ABSL
+ikinci iki[NUM]+NUM.ORD+CASE.ABSL
+bunlar bu[PRON]+PL+CASE.ABSL
+geldim gel[VERB]+TAM.PPFV.KNWN+VB.1sg
+gidiyorum git[VERB]+TAM.PRS.IPFV+PRED.1sg
+yapacaksınız yap[VERB]+TAM.FUT+PRED.2pl
+okumalıyız oku[VERB]+TAM.NEC+PRED.1pl
+gelmeden gel[VERB]+CVB.V.3
+`
+
+/*
+doctor [-data DIR] [-sums FILE] [-golden FILE]
+Checks the data files and prints a health report: whether the files load, their SHA-256 sums (checked
+against a file in the format of sha256sum if given), the problems found by Analyzer.Verify and whether
+the forms of a golden file (or a built-in sample) round-trip through generation and analysis.
+Exits with status 1 if a check fails; the problems of Verify are only warnings.
+*/
+func doctorCmd(args []string) {
+	fs := flag.NewFlagSet("doctor", flag.ExitOnError)
+	data := fs.String("data", ".", "directory of the data files")
+	sums := fs.String("sums", "", "file of the expected SHA-256 sums of the data files, in the format of sha256sum")
+	golden := fs.String("golden", "", "golden file of forms to round-trip (see analysis.ReadGolden)")
+	fs.Parse(args)
+
+	out := bufio.NewWriter(os.Stdout)
+	failed := false
+	fail := func(format string, a ...interface{}) {
+		fmt.Fprintf(out, "FAIL "+format+"\n", a...)
+		failed = true
+	}
+	defer func() {
+		if failed {
+			fmt.Fprintln(out, "unhealthy")
+		} else {
+			fmt.Fprintln(out, "healthy")
+		}
+		out.Flush()
+		if failed {
+			os.Exit(1)
+		}
+	}()
+
+	expected := map[string]string{}
+	if *sums != "" {
+		b, err := ioutil.ReadFile(*sums)
+		if err != nil {
+			fail("%v", err)
+			return
+		}
+		for _, line := range strings.Split(string(b), "\n") {
+			if f := strings.Fields(line); len(f) == 2 {
+				expected[filepath.Base(strings.TrimPrefix(f[1], "*"))] = f[0]
+			}
+		}
+	}
+	for _, name := range []string{analysis.CatalogFile, analysis.MorphotacticFile, analysis.LexiconFile} {
+		b, err := ioutil.ReadFile(filepath.Join(*data, name))
+		if err != nil {
+			fail("%v", err)
+			continue
+		}
+		sum := sha256.Sum256(b)
+		s := hex.EncodeToString(sum[:])
+		if e, ok := expected[name]; ok && e != s {
+			fail("%s: sha256 %s, expected %s", name, s, e)
+		} else if ok {
+			fmt.Fprintf(out, "ok   %s: sha256 %s matches\n", name, s)
+		} else {
+			fmt.Fprintf(out, "ok   %s: sha256 %s\n", name, s)
+		}
+	}
+	if failed {
+		return
+	}
+
+	an, err := analysis.Load(*data)
+	if err != nil {
+		fail("%v", err)
+		return
+	}
+	fmt.Fprintf(out, "ok   loaded %d suffixes, %d states and %d roots\n",
+		len(an.Catalog.Tags()), len(an.Tactics.States()), len(an.Lexicon.Entries))
+	for _, err := range an.Verify() {
+		fmt.Fprintf(out, "warn %v\n", err)
+	}
+
+	var gs []analysis.Golden
+	if *golden != "" {
+		gs, err = analysis.LoadGolden(*golden)
+	} else {
+		gs, err = analysis.ReadGolden(strings.NewReader(samples))
+	}
+	if err != nil {
+		fail("%v", err)
+		return
+	}
+	diffs := an.CheckGolden(gs)
+	for _, d := range diffs {
+		fail("round trip %v", d)
+	}
+	if len(diffs) == 0 {
+		fmt.Fprintf(out, "ok   %d forms round-trip through generation and analysis\n", len(gs))
+	}
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+	"github.com/kaan9/turkish-morphology/normalize"
+	"github.com/kaan9/turkish-morphology/pipeline"
+	"github.com/kaan9/turkish-morphology/spell"
+	"github.com/kaan9/turkish-morphology/tokenize"
+)
+
+/*
+The examples are small applications written only against the public packages, showing how they fit together.
+Each reads the files (or stdin) and writes its result to out:
+
+	examples stem [-data DIR] [-stop] [FILE...]
+	examples freq [-data DIR] [-n N] [FILE...]
+	examples spellcheck [-data DIR] [-n N] [-lenient] [-prescriptive] [FILE...]
+	examples conjugate [-data DIR] [-neg] VERB
+*/
+var examples = map[string]func(args []string, out io.Writer) error{
+	"stem":       stemExample,
+	"freq":       freqExample,
+	"spellcheck": spellcheckExample,
+	"conjugate":  conjugateExample,
+}
+
+/*
+examples NAME ARGS...
+Runs one of the example applications
+*/
+func examplesCmd(args []string) {
+	if len(args) == 0 || examples[args[0]] == nil {
+		var names []string
+		for name := range examples {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fatal(fmt.Errorf("usage: examples %s [-data DIR] ARGS...", strings.Join(names, "|")))
+	}
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	if err := examples[args[0]](args[1:], out); err != nil {
+		out.Flush()
+		fatal(err)
+	}
+}
+
+/* parses the arguments of an example with its flags and a -data flag, and loads the analyzer */
+func exampleFlags(fs *flag.FlagSet, args []string) (*analysis.Analyzer, error) {
+	data := fs.String("data", ".", "directory of the data files")
+	fs.Parse(args)
+	return analysis.Load(*data)
+}
+
+/*
+Stems a corpus: writes every line with each word replaced by its lemma (kitapları okudum -> kitap oku),
+dropping stopwords if stop is set
+*/
+func stemExample(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("examples stem", flag.ExitOnError)
+	stop := fs.Bool("stop", false, "drop stopwords")
+	an, err := exampleFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	p := pipeline.New(an)
+	return eachInput(fs.Args(), func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			var lemmas []string
+			for _, s := range p.Process(scanner.Text()) {
+				if *stop {
+					lemmas = append(lemmas, s.Lemmas...)
+					continue
+				}
+				for _, t := range s.Tokens {
+					lemmas = append(lemmas, t.Lemma)
+				}
+			}
+			fmt.Fprintln(out, strings.Join(lemmas, " "))
+		}
+		return scanner.Err()
+	})
+}
+
+/*
+Builds a frequency dictionary: the lemmas of the corpus with their part of speech, most frequent first,
+with their count and their most frequent forms. Words without an analysis have the part of speech ?.
+*/
+func freqExample(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("examples freq", flag.ExitOnError)
+	n := fs.Int("n", 0, "only write the `n` most frequent lemmas (0 writes all)")
+	an, err := exampleFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	type entry struct {
+		lemma, pos string
+		count      int
+		forms      map[string]int
+	}
+	entries := map[[2]string]*entry{}
+	p := pipeline.New(an)
+	err = eachInput(fs.Args(), func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			for _, s := range p.Process(scanner.Text()) {
+				for _, t := range s.Tokens {
+					pos := "?"
+					if t.Analysis != nil {
+						pos = t.Analysis.POS
+					}
+					k := [2]string{t.Lemma, pos}
+					e := entries[k]
+					if e == nil {
+						e = &entry{lemma: t.Lemma, pos: pos, forms: map[string]int{}}
+						entries[k] = e
+					}
+					e.count++
+					e.forms[normalize.Lower(t.Surface)]++
+				}
+			}
+		}
+		return scanner.Err()
+	})
+	if err != nil {
+		return err
+	}
+
+	var es []*entry
+	for _, e := range entries {
+		es = append(es, e)
+	}
+	sort.Slice(es, func(i, j int) bool {
+		if es[i].count != es[j].count {
+			return es[i].count > es[j].count
+		}
+		return es[i].lemma+es[i].pos < es[j].lemma+es[j].pos
+	})
+	if *n > 0 && *n < len(es) {
+		es = es[:*n]
+	}
+	for _, e := range es {
+		fmt.Fprintf(out, "%d\t%s\t%s\t%s\n", e.count, e.lemma, e.pos, strings.Join(frequent(e.forms, 3), " "))
+	}
+	return nil
+}
+
+/* returns up to n keys of the counts, most frequent first */
+func frequent(counts map[string]int, n int) []string {
+	var keys []string
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	if len(keys) > n {
+		keys = keys[:n]
+	}
+	return keys
+}
+
+/* Spellchecks a file: writes each misspelled word with its line number and up to n suggestions */
+func spellcheckExample(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("examples spellcheck", flag.ExitOnError)
+	n := fs.Int("n", 3, "the number of suggestions for a misspelled word")
+	lenient := fs.Bool("lenient", false, "suggest separating an attached interrogative particle (geliyormusun)")
+	prescriptive := fs.Bool("prescriptive", false, "flag the double plurals of foreign plurals (evraklarda)")
+	an, err := exampleFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	an.Options.Lenient = *lenient
+	if *prescriptive {
+		an.Options.ForeignPlurals = analysis.Prescriptive
+	}
+	c := spell.New(an)
+	line := 0
+	return eachInput(fs.Args(), func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			line++
+			for _, t := range tokenize.Tokenize(scanner.Text()) {
+				if t.Kind == tokenize.Word && !c.Check(t.Text) {
+					fmt.Fprintf(out, "%d: %s\t%s\n", line, t.Text, strings.Join(c.Suggest(t.Text, *n), ", "))
+				}
+			}
+		}
+		return scanner.Err()
+	})
+}
+
+/* the rows of a conjugation table: the name of each tense and the suffixes forming it, followed by a person */
+var tenses = [][2]string{
+	{"present", "TAM.PRS.IPFV PRED"},
+	{"past", "TAM.PPFV.KNWN VB"},
+	{"inferred past", "TAM.PPFV.INFR PRED"},
+	{"future", "TAM.FUT PRED"},
+	{"aorist", "TAM.AOR PRED"},
+	{"necessitative", "TAM.NEC PRED"},
+	{"conditional", "TAM.COND VB"},
+	{"optative", "OPT"},
+	{"imperative", "IMP"},
+}
+
+/* the columns of a conjugation table */
+var persons = []string{"1sg", "2sg", "3sg", "1pl", "2pl", "3pl"}
+
+/*
+Generates a conjugation table: the forms of a verb of the lexicon in each tense and person, negated if neg
+is set. A cell with several forms (the two 2pl imperatives) lists them all.
+*/
+func conjugateExample(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("examples conjugate", flag.ExitOnError)
+	neg := fs.Bool("neg", false, "conjugate the negative")
+	an, err := exampleFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: examples conjugate [-data DIR] [-neg] VERB")
+	}
+	es := an.Entries(fs.Arg(0), "VERB")
+	if len(es) == 0 {
+		return fmt.Errorf("%s[VERB] is not in the lexicon", fs.Arg(0))
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "\t%s\n", strings.Join(persons, "\t"))
+	for _, tense := range tenses {
+		slots := strings.Fields(tense[1])
+		if *neg {
+			slots = append([]string{"NEG.NEG"}, slots...)
+		}
+		cells := map[string][]string{}
+		for _, e := range es {
+			for _, a := range an.Paradigm(e, analysis.Textbook, slots...) {
+				tag := a.Tags[len(a.Tags)-1]
+				person := tag[strings.LastIndex(tag, ".")+1:][:3] /* IMP.2pl2 is a 2pl */
+				if !contains(cells[person], a.Word.String()) {
+					cells[person] = append(cells[person], a.Word.String())
+				}
+			}
+		}
+		row := []string{tense[0]}
+		for _, p := range persons {
+			if len(cells[p]) == 0 {
+				row = append(row, "-")
+			} else {
+				row = append(row, strings.Join(cells[p], "/"))
+			}
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
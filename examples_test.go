@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const exampleText = "Çocuklar kitapları okudular. Bu evde mi yaşıyorsun?\nEvlerde kitaplerim var.\n"
+
+/* runs an example on exampleText with the data files of the repository and returns its output */
+func runExample(t *testing.T, name string, args ...string) string {
+	return runExampleOn(t, exampleText, name, args...)
+}
+
+/* runs an example on a text with the data files of the repository and returns its output */
+func runExampleOn(t *testing.T, text, name string, args ...string) string {
+	dir, err := ioutil.TempDir("", "examples")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "text.txt")
+	if err := ioutil.WriteFile(file, []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := examples[name](append(append([]string{"-data", "."}, args...), file), &out); err != nil {
+		t.Fatalf("examples %s %v error: %v", name, args, err)
+	}
+	return out.String()
+}
+
+func TestExamples(t *testing.T) {
+	valid := [][]string{{"stem"}, {"stem", "-stop"}, {"freq", "-n", "2"}, {"spellcheck"}}
+	valid_out := []string{
+		"çocuk kitap oku bu ev mi yaşa\nev kitaplerim var\n",
+		"çocuk kitap oku ev yaşa\nev kitaplerim var\n",
+		"2\tev\tNOUN\tevde evlerde\n1\tbu\tPRON\tbu\n",
+		"2: kitaplerim\tkitaplarım\n",
+	}
+	for i, args := range valid {
+		if out := runExample(t, args[0], args[1:]...); out != valid_out[i] {
+			t.Errorf("examples %v = %q, expected %q", args, out, valid_out[i])
+		}
+	}
+}
+
+/* the forms are lowercased with Turkish casing: IŞIK is ışık and İstanbul is istanbul */
+func TestFreqExample(t *testing.T) {
+	out := runExampleOn(t, "İstanbul İSTANBUL istanbul\nIŞIK ışık\n", "freq")
+	for _, line := range []string{"3\tistanbul\tNOUN\tistanbul\n", "\tışık\n"} {
+		if !strings.Contains(out, line) {
+			t.Errorf("examples freq = %q, expected to contain %q", out, line)
+		}
+	}
+	if strings.Contains(out, "işik") || strings.Contains(out, "i\u0307") {
+		t.Errorf("examples freq = %q, expected Turkish lowercase forms", out)
+	}
+}
+
+func TestConjugateExample(t *testing.T) {
+	var out bytes.Buffer
+	if err := conjugateExample([]string{"-data", ".", "gel"}, &out); err != nil {
+		t.Fatalf("examples conjugate gel error: %v", err)
+	}
+	for _, row := range []string{
+		"present geliyorum geliyorsun geliyor geliyoruz geliyorsunuz geliyorlar",
+		"past geldim geldin geldi geldik geldiniz geldiler",
+		"aorist gelirim gelirsin gelir geliriz gelirsiniz gelirler",
+		"imperative - gel gelsin - gelin/geliniz gelsinler",
+	} {
+		if !strings.Contains(strings.Join(strings.Fields(out.String()), " "), row) {
+			t.Errorf("examples conjugate gel = %s, expected the row %q", out.String(), row)
+		}
+	}
+	if err := conjugateExample([]string{"-data", ".", "ev"}, &out); err == nil {
+		t.Errorf("examples conjugate ev succeeded, expected an error for a noun")
+	}
+}
@@ -2,4 +2,7 @@ module github.com/kaan9/turkish-morphology
 
 go 1.13
 
-require github.com/BurntSushi/toml v0.3.1
+require (
+	github.com/BurntSushi/toml v0.3.1
+	golang.org/x/text v0.3.8
+)
@@ -0,0 +1,127 @@
+package inflection
+
+/* the kinds of final characters of a stem that select an allomorph, with the harmony of the stem */
+const (
+	final_vowel = iota
+	final_voiced
+	final_voiceless
+	finals
+)
+
+/* a surface form of a suffix: the characters it appends and the harmony of the stem after them */
+type allomorph struct {
+	body    []rune
+	harmony uint8
+}
+
+/*
+Allomorphs holds the finite set of surface forms of a suffix, one for each harmony (front and round) of the
+last vowel of a stem and class of its final character (vowel, voiced or voiceless consonant), so that
+appending the suffix is a table lookup and a copy instead of resolving it character by character.
+Appending with Allomorphs gives the same stem as Stem.Append.
+*/
+type Allomorphs struct {
+	suffix Suffix
+	empty  bool /* appends nothing */
+	drop   bool /* drops a final vowel of the stem (-Iyor) */
+	buffer bool /* replaces a final (n) of the stem with its buffer y: eviyle */
+	next   rune /* the first character appended after a final consonant, which resolves it */
+	forms  [finals][harmony_bits + 1]allomorph
+}
+
+/* returns stems whose last vowel has each harmony, ending in each kind of character */
+func representatives() (stems [finals][harmony_bits + 1]Stem) {
+	for _, v := range "aeoö" {
+		h := class(v) & harmony_bits
+		stems[final_vowel][h] = Stem{v, 'l', v}
+		stems[final_voiced][h] = Stem{v, 'l'}
+		stems[final_voiceless][h] = Stem{v, 't'}
+	}
+	return stems
+}
+
+/* computes the allomorphs of a suffix by appending it to a representative stem of each harmony and final character */
+func NewAllomorphs(suffix Suffix) *Allomorphs {
+	t := &Allomorphs{suffix: suffix}
+	t.empty = suffix.Head == 0 && len(suffix.Body) == 0 && suffix.Tail == 0
+	t.buffer = buffered(suffix)
+	t.drop = len(suffix.Body) != 0 && class(suffix.Body[0])&vowel != 0 &&
+		(suffix.Head == 0 || class(suffix.Head)&vowel != 0)
+	switch {
+	case suffix.Head != 0 && class(suffix.Head)&vowel != 0:
+		t.next = suffix.Head
+	case len(suffix.Body) != 0:
+		t.next = suffix.Body[0]
+	case suffix.Tail != 0:
+		t.next = 'N'
+	}
+	for f, stems := range representatives() {
+		for _, stem := range stems {
+			h := stem.harmony()
+			s, after := append(Stem(nil), stem...).append(suffix, h)
+			n := len(stem)
+			if f == final_vowel && t.drop {
+				n--
+			}
+			if f == final_vowel && !t.drop {
+				h = class(stem[len(stem)-1]) & harmony_bits
+			}
+			t.forms[f][h] = allomorph{body: []rune(s[n:]), harmony: after}
+		}
+	}
+	return t
+}
+
+/* returns the suffix of the allomorphs */
+func (t *Allomorphs) Suffix() Suffix {
+	return t.suffix
+}
+
+/* Same as Stem.Append(t.Suffix()). Does not modify the stem */
+func (t *Allomorphs) Append(stem Stem) Stem {
+	s := make(Stem, len(stem), len(stem)+len(t.suffix.Body)+2)
+	copy(s, stem)
+	s, _ = t.append(s, stem.harmony())
+	return s
+}
+
+/* Same as Stem.AppendInPlace(t.Suffix()) */
+func (t *Allomorphs) AppendInPlace(stem Stem) Stem {
+	s, _ := t.append(stem, stem.harmony())
+	return s
+}
+
+/* same as s.append(t.suffix, h) */
+func (t *Allomorphs) append(s Stem, h uint8) (Stem, uint8) {
+	if t.empty {
+		return s, h
+	}
+	n := len(s)
+	if t.buffer && n > 1 && s[n-1] == 'N' {
+		s, n = s[:n-1], n-1
+	}
+	last := class(s[n-1])
+	switch {
+	case last&vowel != 0 && t.drop:
+		a := t.forms[final_vowel][h]
+		return append(s[:n-1], a.body...), a.harmony
+	case last&vowel != 0:
+		a := t.forms[final_vowel][last&harmony_bits]
+		if len(a.body) == 0 {
+			return s, h
+		}
+		return append(s, a.body...), a.harmony
+	case t.next == 0:
+		return s, h
+	}
+	var prev rune
+	if n > 1 {
+		prev = s[n-2]
+	}
+	s[n-1] = resolve_cons(prev, s[n-1], t.next)
+	a := t.forms[final_voiced][h]
+	if class(s[n-1])&unvoiced != 0 {
+		a = t.forms[final_voiceless][h]
+	}
+	return append(s, a.body...), a.harmony
+}
@@ -0,0 +1,58 @@
+package inflection
+
+import (
+	"reflect"
+	"testing"
+)
+
+/* appends every suffix to every root and to every root followed by a suffix, with Stem.Append and Allomorphs */
+func TestAllomorphs(t *testing.T) {
+	var stems []Stem
+	for _, s := range roots {
+		root, _ := ParseRoot(s)
+		stems = append(stems, Stem(root))
+		for _, s := range suffixes {
+			suf, _ := ParseSuffix(s)
+			stems = append(stems, Stem(root).Append(suf))
+		}
+	}
+	for _, s := range append(suffixes, "Iyor", "ken", "gil", "(y)ken", "(ş)Ar", "(I)msI", "ki") {
+		suf, ok := ParseSuffix(s)
+		if !ok {
+			t.Fatalf("ParseSuffix(%q) failed", s)
+		}
+		a := NewAllomorphs(suf)
+		for _, stem := range stems {
+			expected := stem.Append(suf)
+			if out := a.Append(stem); !reflect.DeepEqual(out, expected) {
+				t.Errorf("NewAllomorphs(%v).Append(%v) = %v, expected %v", suf, stem, out, expected)
+			}
+			in_place := append(make(Stem, 0, len(stem)+len(suf.Body)+2), stem...)
+			if out := a.AppendInPlace(in_place); !reflect.DeepEqual(out, expected) {
+				t.Errorf("NewAllomorphs(%v).AppendInPlace(%v) = %v, expected %v", suf, stem, out, expected)
+			}
+			_, h := append(Stem(nil), stem...).append(suf, stem.harmony())
+			if _, out := a.append(append(Stem(nil), stem...), stem.harmony()); out != h {
+				t.Errorf("harmony of NewAllomorphs(%v).append(%v) = %b, expected %b", suf, stem, out, h)
+			}
+		}
+	}
+}
+
+func BenchmarkAllomorphs(b *testing.B) {
+	root, sufs, _ := ParseRootSuffixes(chain)
+	var as []*Allomorphs
+	for _, suf := range sufs {
+		as = append(as, NewAllomorphs(suf))
+	}
+	stem := make(Stem, 0, 64)
+	word := make(Word, 0, 64)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		stem = append(stem[:0], root...)
+		for _, a := range as {
+			stem = a.AppendInPlace(stem)
+		}
+		word = stem.AppendWord(word[:0])
+	}
+}
@@ -0,0 +1,43 @@
+package inflection
+
+import (
+	"testing"
+)
+
+/*
+The v1 API of the package, which later versions keep with the same signatures and results so that existing
+importers are not broken: the types Root, Stem, Word and Suffix, their String methods, Stem.Append, Stem.Word,
+ParseRoot, ParseSuffix, ParseRootSuffixes, the fields of Suffix and Vowel. The assignments fail to compile if a signature changes.
+*/
+var (
+	_ func(string) (Root, bool)           = ParseRoot
+	_ func(string) (Suffix, bool)         = ParseSuffix
+	_ func(string) (Root, []Suffix, bool) = ParseRootSuffixes
+	_ func(Stem, Suffix) Stem             = Stem.Append
+	_ func(Stem) Word                     = Stem.Word
+	_ func(Root) string                   = Root.String
+	_ func(Stem) string                   = Stem.String
+	_ func(Word) string                   = Word.String
+	_ func(Suffix) string                 = Suffix.String
+	_ map[rune]bool                       = Vowel
+	_                                     = Suffix{Head: 0, Tail: 0, Body: []rune{}}
+)
+
+/* the example of the README, as written by v1 importers */
+func TestV1API(t *testing.T) {
+	root, ok := ParseRoot("yap")
+	if !ok {
+		t.Fatalf("ParseRoot(yap) failed")
+	}
+	s := Stem(root)
+	for _, x := range []string{"Iyor", "(y)sA", "(I)m"} {
+		suf, ok := ParseSuffix(x)
+		if !ok {
+			t.Fatalf("ParseSuffix(%s) failed", x)
+		}
+		s = s.Append(suf)
+	}
+	if w := s.Word().String(); w != "yapıyorsam" {
+		t.Errorf("yap Iyor (y)sA (I)m = %s, expected yapıyorsam", w)
+	}
+}
@@ -0,0 +1,30 @@
+package inflection
+
+import (
+	"github.com/kaan9/turkish-morphology/corpus"
+)
+
+/* A RootSuffixSpec is a root and the suffixes appended to it, as parsed by ParseRootSuffixes */
+type RootSuffixSpec struct {
+	Root     Root
+	Suffixes []Suffix
+}
+
+/*
+Appends the suffixes of each spec to its root on workers goroutines (runtime.GOMAXPROCS if workers <= 0)
+and returns the words in the order of the specs. Each worker inflects with its own Builder (see corpus.Each).
+*/
+func BatchInflect(specs []RootSuffixSpec, workers int) []Word {
+	words := make([]Word, len(specs))
+	corpus.Each(len(specs), workers, func() func(int) {
+		b := &Builder{}
+		return func(i int) {
+			b.Reset(specs[i].Root)
+			for _, suf := range specs[i].Suffixes {
+				b.Append(suf)
+			}
+			words[i] = b.AppendWord(nil)
+		}
+	})
+	return words
+}
@@ -0,0 +1,27 @@
+package inflection
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBatchInflect(t *testing.T) {
+	valid := []string{
+		"yap Iyor (y)sA (I)m", "bu(n) lAr (n)In ki lAr DAn", "kitaB (y)I", "giD", "ka(n)",
+		"avrupa lI lAş DIr (y)AmA DIK lAr (I)mIz DAn (y)mIş sInIz",
+	}
+	valid_out := []Word{
+		Word("yapıyorsam"), Word("bunlarınkilerden"), Word("kitabı"), Word("git"), Word("ka"),
+		Word("avrupalılaştıramadıklarımızdanmışsınız"),
+	}
+	var specs []RootSuffixSpec
+	for _, s := range valid {
+		root, sufs, _ := ParseRootSuffixes(s)
+		specs = append(specs, RootSuffixSpec{root, sufs})
+	}
+	for _, workers := range []int{0, 1, 3, 100} {
+		if words := BatchInflect(specs, workers); !reflect.DeepEqual(words, valid_out) {
+			t.Errorf("BatchInflect(%d) = %v, expected %v", workers, words, valid_out)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package inflection
+
+/*
+A Builder appends suffixes to a stem in place, for generating many forms quickly: it reuses its array
+and keeps the vowel harmony of the stem, which Stem.Append finds again by scanning the stem for every suffix.
+Undo removes the last suffix, so a Builder can walk a tree of suffix chains without copying stems.
+The Stem of a Builder is only valid until it is changed.
+*/
+type Builder struct {
+	stem    Stem
+	harmony uint8 /* the harmony of the stem before its final character */
+	undo    []undo
+}
+
+/* the state of a Builder before a suffix was appended */
+type undo struct {
+	len     int
+	last    rune
+	harmony uint8
+}
+
+/* creates a Builder from a root */
+func NewBuilder(root Root) *Builder {
+	b := &Builder{}
+	b.Reset(root)
+	return b
+}
+
+/* starts again from a root, keeping the arrays of the Builder */
+func (b *Builder) Reset(root Root) {
+	b.stem = append(b.stem[:0], root...)
+	b.harmony = b.stem.harmony()
+	b.undo = b.undo[:0]
+}
+
+/* appends the suffix to the stem, see Stem.Append */
+func (b *Builder) Append(suffix Suffix) {
+	b.undo = append(b.undo, undo{len(b.stem), b.stem[len(b.stem)-1], b.harmony})
+	b.stem, b.harmony = b.stem.append(suffix, b.harmony)
+}
+
+/* removes the last suffix appended since the last Reset, returns false if there is none */
+func (b *Builder) Undo() bool {
+	if len(b.undo) == 0 {
+		return false
+	}
+	u := b.undo[len(b.undo)-1]
+	b.undo = b.undo[:len(b.undo)-1]
+	/* appending changes only the final character of the stem and adds characters after it */
+	b.stem = b.stem[:u.len]
+	b.stem[u.len-1] = u.last
+	b.harmony = u.harmony
+	return true
+}
+
+/* returns the number of suffixes appended since the last Reset */
+func (b *Builder) Len() int {
+	return len(b.undo)
+}
+
+/* returns the stem, which is only valid until the Builder is changed */
+func (b *Builder) Stem() Stem {
+	return b.stem
+}
+
+/* appends the fully resolved stem to w, see Stem.AppendWord */
+func (b *Builder) AppendWord(w Word) Word {
+	return b.stem.AppendWord(w)
+}
@@ -0,0 +1,131 @@
+package inflection
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+var roots = []string{"ev", "kitaB", "bu(n)", "oku", "söyle", "ye", "tanı", "giD", "renK", "saat", "ağaC", "çocuK"}
+
+var suffixes = []string{
+	"", "lAr", "(I)m", "(I)mIz", "(s)I(n)", "lArI(n)", "(y)I", "(y)A", "(n)In", "DA", "DAn", "(y)lA", "ki",
+	"(y)Im", "sIn", "DIr", "(y)DI", "(y)mIş", "(y)sA", "Iyor", "(A)r", "(I)r", "z", "mA", "(y)AmA", "(y)AcAK",
+	"DI", "mIş", "sA", "mAlI", "(I)n", "(I)ş", "(I)l", "t", "(y)Abil", "DIK", "(y)Ip", "(I)ncI", "lIK", "CI", "t",
+}
+
+/* appends random suffixes with Stem.Append, Stem.AppendInPlace and a Builder, undoing some with the Builder */
+func TestBuilder(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 2000; n++ {
+		root, _ := ParseRoot(roots[r.Intn(len(roots))])
+		stems := []Stem{Stem(root)}
+		in_place := append(Stem(nil), root...)
+		b := NewBuilder(root)
+		for k := r.Intn(8); k > 0; k-- {
+			if r.Intn(4) == 0 && b.Undo() {
+				stems = stems[:len(stems)-1]
+				in_place = append(Stem(nil), stems[len(stems)-1]...)
+				continue
+			}
+			suf, _ := ParseSuffix(suffixes[r.Intn(len(suffixes))])
+			stem := stems[len(stems)-1].Append(suf)
+			stems = append(stems, stem)
+			in_place = in_place.AppendInPlace(suf)
+			b.Append(suf)
+			if !reflect.DeepEqual(in_place, stem) {
+				t.Fatalf("AppendInPlace(%v) = %v, expected %v", suf, in_place, stem)
+			}
+			if !reflect.DeepEqual(b.Stem(), stem) {
+				t.Fatalf("(*Builder).Append(%v) = %v, expected %v", suf, b.Stem(), stem)
+			}
+		}
+		stem := stems[len(stems)-1]
+		if !reflect.DeepEqual(b.Stem(), stem) {
+			t.Fatalf("(*Builder).Stem() = %v, expected %v", b.Stem(), stem)
+		}
+		if b.Len() != len(stems)-1 {
+			t.Fatalf("(*Builder).Len() = %d, expected %d", b.Len(), len(stems)-1)
+		}
+		if w := b.AppendWord(Word("x")); !reflect.DeepEqual(w, append(Word("x"), stem.Word()...)) {
+			t.Fatalf("(*Builder).AppendWord(x) = %v, expected x%v", w, stem.Word())
+		}
+	}
+}
+
+func TestBuilderUndo(t *testing.T) {
+	root, sufs, _ := ParseRootSuffixes("oku Iyor (y)Im")
+	b := NewBuilder(root)
+	for _, suf := range sufs {
+		b.Append(suf)
+	}
+	valid_out := []Stem{Stem("okuyorum"), Stem("okuyor"), Stem("oku")}
+	for i, stem := range valid_out {
+		if !reflect.DeepEqual(b.Stem(), stem) {
+			t.Errorf("(*Builder).Stem() after %d undos = %v, expected %v", i, b.Stem(), stem)
+		}
+		b.Undo()
+	}
+	if b.Undo() {
+		t.Errorf("(*Builder).Undo() of a root = true, expected false")
+	}
+}
+
+/* the long suffix chain of TestAppend */
+const chain = "tanı (I)ş DIr (I)l (y)AmA (y)Abil (y)AcAK lAr DAn (y)mIş çA (s)I(n) (y)A"
+
+func BenchmarkAppend(b *testing.B) {
+	root, sufs, _ := ParseRootSuffixes(chain)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		stem := Stem(root)
+		for _, suf := range sufs {
+			stem = stem.Append(suf)
+		}
+		stem.Word()
+	}
+}
+
+func BenchmarkAppendInPlace(b *testing.B) {
+	root, sufs, _ := ParseRootSuffixes(chain)
+	stem := make(Stem, 0, 64)
+	word := make(Word, 0, 64)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		stem = append(stem[:0], root...)
+		for _, suf := range sufs {
+			stem = stem.AppendInPlace(suf)
+		}
+		word = stem.AppendWord(word[:0])
+	}
+}
+
+func BenchmarkBuilder(b *testing.B) {
+	root, sufs, _ := ParseRootSuffixes(chain)
+	bl := NewBuilder(root)
+	word := make(Word, 0, 64)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		bl.Reset(root)
+		for _, suf := range sufs {
+			bl.Append(suf)
+		}
+		word = bl.AppendWord(word[:0])
+	}
+}
+
+/* generates every form of a chain by undoing and appending its last suffix, like a search of the morphotactics */
+func BenchmarkBuilderUndo(b *testing.B) {
+	root, sufs, _ := ParseRootSuffixes(chain)
+	bl := NewBuilder(root)
+	for _, suf := range sufs[:len(sufs)-1] {
+		bl.Append(suf)
+	}
+	word := make(Word, 0, 64)
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		bl.Append(sufs[len(sufs)-1])
+		word = bl.AppendWord(word[:0])
+		bl.Undo()
+	}
+}
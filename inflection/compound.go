@@ -0,0 +1,63 @@
+package inflection
+
+/* the head marker -(s)I(n) of compounds, the same as the 3rd person possessive suffix */
+var HeadMarker = Suffix{Head: 's', Tail: 'n', Body: []rune("I")}
+
+/*
+A Compound is a noun compound whose head takes the head marker: buz + dolaB -> buzdolabı, çay + bahçe ->
+çay bahçesi. The head marker is kept apart from the head so that a possessive suffix replaces it
+(buzdolabım, not buzdolabıım) and the plural comes before it (buzdolapları), while the suffixes after it
+realize its n (çay bahçesinde), except a buffer y, which replaces it (buzdolabıyla, çay bahçesiyle). Space is set for compounds written as two words.
+*/
+type Compound struct {
+	Modifier Word     /* not inflected */
+	Head     Stem     /* the head noun and the suffixes before the marker */
+	Marker   Suffix   /* the head marker or the possessive suffix replacing it */
+	Suffixes []Suffix /* the suffixes after the marker */
+	Space    bool
+}
+
+/* creates a compound of a modifier and a head noun with the head marker */
+func NewCompound(modifier Word, head Stem, space bool) Compound {
+	return Compound{Modifier: modifier, Head: head, Marker: HeadMarker, Space: space}
+}
+
+/* returns the compound with a plural suffix before its marker: buzdolabı -> buzdolapları */
+func (c Compound) Plural(pl Suffix) Compound {
+	c.Head = c.Head.Append(pl)
+	return c
+}
+
+/* returns the compound with a possessive suffix replacing its marker: buzdolabı -> buzdolabım */
+func (c Compound) Possessive(pos Suffix) Compound {
+	c.Marker = pos
+	return c
+}
+
+/* returns the compound with a suffix appended after its marker: çay bahçesi -> çay bahçesinde */
+func (c Compound) Append(suf Suffix) Compound {
+	c.Suffixes = append(append([]Suffix(nil), c.Suffixes...), suf)
+	return c
+}
+
+/* returns the inflected head of the compound, without the modifier */
+func (c Compound) Stem() Stem {
+	s := c.Head.Append(c.Marker)
+	for _, suf := range c.Suffixes {
+		s = s.Append(suf)
+	}
+	return s
+}
+
+/* returns the written compound, with a space between the modifier and the head if Space is set */
+func (c Compound) Word() Word {
+	w := append(Word(nil), c.Modifier...)
+	if c.Space {
+		w = append(w, ' ')
+	}
+	return c.Stem().AppendWord(w)
+}
+
+func (c Compound) String() string {
+	return c.Word().String()
+}
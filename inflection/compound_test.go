@@ -0,0 +1,71 @@
+package inflection
+
+import (
+	"testing"
+)
+
+func TestCompound(t *testing.T) {
+	suf := func(s string) Suffix {
+		suf, _ := ParseSuffix(s)
+		return suf
+	}
+	buzdolabi := NewCompound(Word("buz"), Stem("dolaB"), false)
+	cay_bahcesi := NewCompound(Word("çay"), Stem("bahçe"), true)
+	ayakkabi := NewCompound(Word("ayak"), Stem("kaB"), false)
+
+	valid := []Compound{
+		buzdolabi,
+		buzdolabi.Possessive(suf("(I)m")),
+		buzdolabi.Possessive(suf("(I)mIz")).Append(suf("DA")),
+		buzdolabi.Possessive(suf("(s)I(n)")),
+		buzdolabi.Append(suf("(y)I")),
+		buzdolabi.Plural(suf("lAr")),
+		buzdolabi.Plural(suf("lAr")).Append(suf("(y)A")),
+		buzdolabi.Plural(suf("lAr")).Possessive(suf("(I)m")),
+		cay_bahcesi,
+		cay_bahcesi.Append(suf("DA")),
+		cay_bahcesi.Append(suf("DA")).Append(suf("ki")),
+		cay_bahcesi.Possessive(suf("(I)m")),
+		cay_bahcesi.Plural(suf("lAr")).Append(suf("DA")),
+		ayakkabi.Possessive(suf("(I)n")).Append(suf("(n)In")),
+		buzdolabi.Append(suf("(y)lA")),
+		cay_bahcesi.Append(suf("(y)lA")),
+		buzdolabi.Plural(suf("lAr")).Append(suf("(y)lA")),
+		buzdolabi.Possessive(suf("(I)n")).Append(suf("(y)lA")),
+		cay_bahcesi.Append(suf("(y)DI")),
+	}
+	valid_out := []string{
+		"buzdolabı",
+		"buzdolabım",
+		"buzdolabımızda",
+		"buzdolabı",
+		"buzdolabını",
+		"buzdolapları",
+		"buzdolaplarına",
+		"buzdolaplarım",
+		"çay bahçesi",
+		"çay bahçesinde",
+		"çay bahçesindeki",
+		"çay bahçem",
+		"çay bahçelerinde",
+		"ayakkabının",
+		"buzdolabıyla",
+		"çay bahçesiyle",
+		"buzdolaplarıyla",
+		"buzdolabınla",
+		"çay bahçesiydi",
+	}
+	for i, c := range valid {
+		if w := c.String(); w != valid_out[i] {
+			t.Errorf("(%v %v %v %v).Word() = %s, expected %s", c.Modifier, c.Head, c.Marker, c.Suffixes, w, valid_out[i])
+		}
+	}
+
+	/* appending to a compound does not change it */
+	c := cay_bahcesi.Append(suf("DA"))
+	c.Append(suf("ki"))
+	c.Append(suf("(y)I"))
+	if w := c.String(); w != "çay bahçesinde" {
+		t.Errorf("Append changed the compound to %s", w)
+	}
+}
@@ -0,0 +1,68 @@
+package inflection
+
+/* the cost of substituting a character for a similar one (see Similar), half that of any other edit */
+const SimilarCost = 0.5
+
+/* the consonants that alternate by voicing, as pairs of a voiceless consonant and its voiced counterpart */
+var voicing = [][2]rune{{'p', 'b'}, {'ç', 'c'}, {'t', 'd'}, {'k', 'g'}, {'k', 'ğ'}}
+
+/*
+Reports whether two different characters are phonologically similar, so that writing one for the other is
+a likely slip: vowels of the same harmony class, which differ only in front and round qualities (ı i u ü,
+a e, o ö), and consonants that alternate by voicing (t d, p b, ç c, k g ğ).
+*/
+func Similar(a, b rune) bool {
+	if a == b {
+		return false
+	}
+	ca, cb := class(a), class(b)
+	if ca&vowel != 0 && cb&vowel != 0 {
+		return ca&high == cb&high && (ca&high != 0 || ca&round == cb&round)
+	}
+	for _, p := range voicing {
+		if (a == p[0] || a == p[1]) && (b == p[0] || b == p[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+/* returns the cost of substituting b for a: 0 if they are equal, SimilarCost if they are Similar and 1 otherwise */
+func SubstitutionCost(a, b rune) float64 {
+	switch {
+	case a == b:
+		return 0
+	case Similar(a, b):
+		return SimilarCost
+	}
+	return 1
+}
+
+/*
+Returns the edit distance between two words in which insertions and deletions cost 1 and substitutions
+cost SubstitutionCost, so that words differing by harmony or voicing are closer than words differing
+otherwise: kitapda is 0.5 from kitapta while kitapma is 1 from it.
+The distance is never more than the Levenshtein distance.
+*/
+func Distance(a, b []rune) float64 {
+	row := make([]float64, len(b)+1)
+	for i := range row {
+		row[i] = float64(i)
+	}
+	for _, c := range a {
+		diag := row[0]
+		row[0]++
+		for i := 1; i <= len(b); i++ {
+			d := diag + SubstitutionCost(c, b[i-1])
+			diag = row[i]
+			if row[i]+1 < d {
+				d = row[i] + 1
+			}
+			if row[i-1]+1 < d {
+				d = row[i-1] + 1
+			}
+			row[i] = d
+		}
+	}
+	return row[len(b)]
+}
@@ -0,0 +1,37 @@
+package inflection
+
+import (
+	"testing"
+)
+
+func TestSimilar(t *testing.T) {
+	valid := [][2]rune{{'ı', 'i'}, {'i', 'ü'}, {'u', 'ı'}, {'a', 'e'}, {'o', 'ö'}, {'d', 't'}, {'b', 'p'}, {'c', 'ç'}, {'g', 'k'}, {'ğ', 'k'}}
+	for _, v := range valid {
+		if !Similar(v[0], v[1]) || !Similar(v[1], v[0]) {
+			t.Errorf("Similar(%c, %c) = false, expected true", v[0], v[1])
+		}
+	}
+
+	invalid := [][2]rune{{'a', 'a'}, {'a', 'ı'}, {'a', 'o'}, {'e', 'ö'}, {'i', 'e'}, {'d', 'b'}, {'s', 'ş'}, {'a', 't'}, {'g', 'ğ'}}
+	for _, v := range invalid {
+		if Similar(v[0], v[1]) {
+			t.Errorf("Similar(%c, %c) = true, expected false", v[0], v[1])
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	valid := [][2]string{
+		{"kitapta", "kitapta"}, {"kitapda", "kitapta"}, {"kitaplerde", "kitaplarda"}, {"kitapma", "kitapta"},
+		{"gidiyorm", "gidiyorum"}, {"kitaplerım", "kitaplarım"}, {"", "ev"}, {"evler", "ev"}, {"gözlük", "gözluk"},
+	}
+	valid_out := []float64{0, 0.5, 1, 1, 1, 0.5, 2, 3, 0.5}
+	for i, v := range valid {
+		if d := Distance([]rune(v[0]), []rune(v[1])); d != valid_out[i] {
+			t.Errorf("Distance(%s, %s) = %v, expected %v", v[0], v[1], d, valid_out[i])
+		}
+		if d := Distance([]rune(v[1]), []rune(v[0])); d != valid_out[i] {
+			t.Errorf("Distance(%s, %s) = %v, expected %v", v[1], v[0], d, valid_out[i])
+		}
+	}
+}
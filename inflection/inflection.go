@@ -3,6 +3,9 @@ package inflection
 import (
 	"regexp"
 	"strings"
+	"unicode"
+
+	"github.com/kaan9/turkish-morphology/normalize"
 )
 
 /* set of voiceless consonants for quick access, fıstıkçı şahap */
@@ -49,18 +52,6 @@ var vowel_to_quality = map[rune]quality{
 	'I': quality{false, false, true},  /* can be both front or back, round or flat but always high */
 }
 
-/* map front, round, high qualities, in order, to vowels */
-var quality_to_vowel = map[quality]rune{
-	quality{false, false, false}: 'a',
-	quality{true, false, false}:  'e',
-	quality{false, false, true}:  'ı',
-	quality{true, false, true}:   'i',
-	quality{false, true, false}:  'o',
-	quality{true, true, false}:   'ö',
-	quality{false, true, true}:   'u',
-	quality{true, true, true}:    'ü',
-}
-
 /*
 A Root can contain only exact characters except the final character which can be B,C,D,K,(n).
 The final letter is realized when a suffix is appended or it is converted to a word.
@@ -87,23 +78,70 @@ type Suffix struct {
 	Body       []rune
 }
 
+/* the classes of a phoneme, as bits of a byte so that they are found by a table lookup */
+const (
+	front uint8 = 1 << iota
+	round
+	high
+	vowel
+	varying  /* A, I */
+	unvoiced /* voiceless consonant */
+)
+
+/* the harmony of a vowel: its front and round qualities */
+const harmony_bits = front | round
+
+/* the classes of the characters of stems and suffixes up to ş, indexed by the character */
+var classes ['ş' + 1]uint8
+
+/* the vowels indexed by their front, round and high classes */
+var vowels [front | round | high + 1]rune
+
+func init() {
+	for c := range voiceless {
+		classes[c] = unvoiced
+	}
+	for v, q := range vowel_to_quality {
+		k := vowel
+		if q.front {
+			k |= front
+		}
+		if q.round {
+			k |= round
+		}
+		if q.high {
+			k |= high
+		}
+		if v == 'A' || v == 'I' {
+			k |= varying
+		} else {
+			vowels[k&^vowel] = v
+		}
+		classes[v] = k
+	}
+}
+
+/* returns the classes of a character */
+func class(c rune) uint8 {
+	if c >= 0 && int(c) < len(classes) {
+		return classes[c]
+	}
+	return 0
+}
+
 /*
-takes in a vowel and front/round harmony it should conform to
-If vowel is A/I, returns the new quality and adjusted form of vowel
-If vowel is exact, returns the same vowel and its quality
+takes in a vowel and the harmony (front and round classes) it should conform to
+If vowel is A/I, returns its form in harmony
+If vowel is exact, returns the same vowel
 */
-func resolve_vowel(vowel rune, front, round bool) (q quality, v rune) {
-	switch vowel {
+func resolve_vowel(v rune, h uint8) rune {
+	switch v {
 	case 'A':
-		v = quality_to_vowel[quality{front, false, false}]
+		return vowels[h&front]
 	case 'I':
-		v = quality_to_vowel[quality{front, round, true}]
-	default:
-		v = vowel
+		return vowels[h|high]
 	}
-	q = vowel_to_quality[v]
-
-	return q, v
+	return v
 }
 
 /*
@@ -111,7 +149,7 @@ takes in B/C/D/K/N or an exact consonant and the previous and next consonant
 returns correct consonant mutation form based on previous and next
 */
 func resolve_cons(prev, c, next rune) rune {
-	if Vowel[next] && prev != 0 && !voiceless[prev] {
+	if class(next)&vowel != 0 && prev != 0 && class(prev)&unvoiced == 0 {
 		switch c {
 		case 'B':
 			c = 'b'
@@ -134,7 +172,7 @@ func resolve_cons(prev, c, next rune) rune {
 			c = 'k'
 		}
 	}
-	if Vowel[prev] && c == 'g' {
+	if c == 'g' && class(prev)&vowel != 0 {
 		c = 'ğ'
 	}
 	if c == 'N' {
@@ -151,18 +189,53 @@ func resolve_cons(prev, c, next rune) rune {
 Combines the suffix with the stem but does not resolve final N/B/C/D/K after appending
 Only resolves the consonant and vowel harmonies of the suffix and the final consonant
 of the original stem if it exists. Does not modify inputted stem
+To append many suffixes without allocating see Builder and Allomorphs, and to inflect a root of the
+lexicon by the tags of its suffixes see analysis.Analyzer.Generate
 */
 func (stem Stem) Append(suffix Suffix) Stem {
-	s := Stem(make([]rune, len(stem)))
+	s := make(Stem, len(stem), len(stem)+len(suffix.Body)+2)
 	copy(s, stem)
+	s, _ = s.append(suffix, stem.harmony())
+	return s
+}
+
+/*
+Same as Append but reuses the array of the stem (like the builtin append), so the stem must not be used
+afterwards. Does not allocate when the array has the capacity for the suffix.
+*/
+func (stem Stem) AppendInPlace(suffix Suffix) Stem {
+	s, _ := stem.append(suffix, stem.harmony())
+	return s
+}
+
+/* returns the harmony (front and round classes) of the latest exact vowel in the stem before its final character */
+func (stem Stem) harmony() uint8 {
+	for i := len(stem) - 2; i >= 0; i-- {
+		if k := class(stem[i]); k&(vowel|varying) == vowel {
+			return k & harmony_bits
+		}
+	}
+	return 0
+}
+
+/*
+Appends the suffix to s in place, given the harmony of s before its final character (see harmony), and
+returns the new stem with its harmony before its final character
+*/
+func (s Stem) append(suffix Suffix, h uint8) (Stem, uint8) {
+	if buffered(suffix) && len(s) > 1 && s[len(s)-1] == 'N' {
+		s = s[:len(s)-1]
+	}
+	n := len(s)
+	before := h
 
 	/* add optional suffix head if it is the opposite type (vowel/consonant) of the stem's final word */
-	if suffix.Head != 0 && Vowel[s[len(s)-1]] != Vowel[suffix.Head] {
+	if suffix.Head != 0 && class(s[len(s)-1])&vowel != class(suffix.Head)&vowel {
 		s = append(s, suffix.Head)
 	}
 
 	/* drop stem-final vowel if suffix begins with a vowel (-Iyor) */
-	if Vowel[s[len(s)-1]] && len(suffix.Body) != 0 && Vowel[suffix.Body[0]] {
+	if class(s[len(s)-1])&vowel != 0 && len(suffix.Body) != 0 && class(suffix.Body[0])&vowel != 0 {
 		s = s[:len(s)-1]
 	}
 
@@ -171,50 +244,78 @@ func (stem Stem) Append(suffix Suffix) Stem {
 		s = append(s, 'N') /* (n) is the only valid suffix */
 	}
 
-	/* get quality of latest exact vowel in stem */
-	front, round := false, false // quality of latest vowel
-	for i := len(stem) - 1; i >= 0; i-- {
-		if Vowel[s[i]] && s[i] != 'A' && s[i] != 'I' {
-			q := vowel_to_quality[s[i]]
-			front, round = q.front, q.round
-			break
-		}
+	/* harmony of latest exact vowel in the stem, which may have been replaced by the suffix */
+	if k := class(s[n-1]); k&(vowel|varying) == vowel {
+		h = k & harmony_bits
 	}
 
-	for i := len(stem) - 1; i < len(s)-1; i++ {
-		if Vowel[s[i]] {
-			var q quality
-			q, s[i] = resolve_vowel(s[i], front, round)
-			front, round = q.front, q.round
+	for i := n - 1; i < len(s)-1; i++ {
+		if class(s[i])&vowel != 0 {
+			s[i] = resolve_vowel(s[i], h)
+			h = class(s[i]) & harmony_bits
 		} else {
 			var prev rune
-			if i == 0 {
-				prev = 0
-			} else {
+			if i > 0 {
 				prev = s[i-1]
 			}
 			s[i] = resolve_cons(prev, s[i], s[i+1])
 		}
 	}
 
-	if Vowel[s[len(s)-1]] {
-		_, s[len(s)-1] = resolve_vowel(s[len(s)-1], front, round)
+	if class(s[len(s)-1])&vowel != 0 {
+		s[len(s)-1] = resolve_vowel(s[len(s)-1], h)
 	}
 
-	return s
+	if len(s) == n {
+		return s, before /* the stem before its final character is unchanged */
+	}
+	return s, h
+}
+
+/*
+reports whether the suffix begins with a buffer y before a consonant, which replaces a final (n) of the stem:
+evi(n) + (y)lA -> eviyle (not evinle), evi(n) + (y)DI -> eviydi, o(n) + (y)sA -> oysa
+*/
+func buffered(suffix Suffix) bool {
+	return suffix.Head == 'y' && len(suffix.Body) != 0 && class(suffix.Body[0])&vowel == 0
 }
 
 /* fully resolves the stem (resolves final consonant) and returns as Word */
 func (stem Stem) Word() Word {
-	w := Word(make([]rune, len(stem)))
-	copy(w, stem)
-	if !Vowel[w[len(w)-1]] {
+	return stem.AppendWord(make(Word, 0, len(stem)))
+}
+
+/* appends the fully resolved stem to w, as Word does, reusing the array of w if it has the capacity */
+func (stem Stem) AppendWord(w Word) Word {
+	w = append(w, stem...)
+	if class(w[len(w)-1])&vowel == 0 {
 		/* value of prev is irrelevant; next == 0 implies a voiceless */
 		w[len(w)-1] = resolve_cons(0, w[len(w)-1], 0)
+		if w[len(w)-1] == 0 {
+			w = w[:len(w)-1] /* unrealized final (n) */
+		}
 	}
 	return w
 }
 
+/*
+Appends the suffixes to the stem and returns only the realized suffixes, without the stem.
+Harmony is computed against the stem, which is the pronounced form of a word written differently:
+3 is pronounced üç and üç + DA -> üçte, so the ending written after 3 is "te" (3'te).
+The stem's final consonant is resolved as part of the stem and is not included in the ending.
+*/
+func (stem Stem) Ending(sufs ...Suffix) Word {
+	s := append(Stem(nil), stem...)
+	for _, suf := range sufs {
+		s = s.AppendInPlace(suf)
+	}
+	w := s.Word()
+	if len(w) <= len(stem) {
+		return Word{}
+	}
+	return w[len(stem):]
+}
+
 func (suffix Suffix) String() string {
 	head, tail := "", ""
 	if suffix.Head != 0 {
@@ -238,11 +339,45 @@ func (word Word) String() string {
 	return string(word)
 }
 
+/* the capitals of the notation of roots and suffixes, which stand for archiphonemes rather than letters */
+const archiphonemes = "ABCDIKN"
+
+/* lowercases a root written capitalized or in uppercase as a word of text with Turkish casing: İstanbul, Ev, KİTAP */
+func foldWord(s string) string {
+	var letters []rune
+	upper := 0
+	for _, c := range s {
+		if unicode.IsLetter(c) {
+			letters = append(letters, c)
+			if unicode.IsUpper(c) {
+				upper++
+			}
+		}
+	}
+	if len(letters) > 1 && (upper == len(letters) || upper == 1 && unicode.IsUpper(letters[0])) {
+		return normalize.Lower(s)
+	}
+	return s
+}
+
+/* lowercases the capitals of a suffix that are not archiphonemes with Turkish casing: LAR -> lAr */
+func foldNotation(s string) string {
+	return strings.Map(func(c rune) rune {
+		if unicode.IsUpper(c) && !strings.ContainsRune(archiphonemes, c) {
+			return unicode.TurkishCase.ToLower(c)
+		}
+		return c
+	}, s)
+}
+
 /*
 The root of a word is a list of exact characters. The final character can be one of
-B/C/D/K or (n). n must be parenthesized if it is used as an optional final character
+B/C/D/K or (n). n must be parenthesized if it is used as an optional final character.
+Decomposed letters are composed first (see normalize.NFC) and a root written as a word of text is lowercased
+(İstanbul -> istanbul, Ev -> ev). Other capitals are not, since kitaP is more likely a mistyped kitaB.
 */
 func ParseRoot(s string) (r Root, ok bool) {
+	s = foldWord(normalize.NFC(s))
 	re := regexp.MustCompile(`^\s*([a-zçğıöşü]*)(?:([a-zçğıöşüBCDK])|(?:\((n)\)))\s*$`)
 	if matches := re.FindStringSubmatch(s); len(matches) == 4 {
 		if matches[3] != "" {
@@ -256,8 +391,11 @@ func ParseRoot(s string) (r Root, ok bool) {
 /*
 The suffix is a sequence of exact characters or A/I/B/C/D/K consisting of a variable body and
 an optional head and tail character marked by parenthesis. The tail can only be (n).
+Decomposed letters are composed first (see normalize.NFC) and capitals that are not A/I/B/C/D/K are lowercased:
+LAR -> lAr, (Y)İm -> (y)im
 */
 func ParseSuffix(s string) (suf Suffix, ok bool) {
+	s = foldNotation(normalize.NFC(s))
 	if s == "" {
 		return Suffix{Head: 0, Tail: 0, Body: []rune{}}, true
 	}
@@ -9,7 +9,7 @@ func TestParseRoot(t *testing.T) {
 	valid := []string{
 		"abc", "yap", "git", "k", "kat", "et", "ççç", "daB", "caC", "saD",
 		"giD", "gök", "göK", "   uouou   ", "\t\r\nvvvrrruuuD\t\r\n", "cac",
-		"bu(n)", "o(n)", "  ka(n)  ",
+		"bu(n)", "o(n)", "  ka(n)  ", "c\u0327ocuK", "İstanbul", "Ev", "KİTAP", "IRMAK",
 	}
 	valid_out := []Root{
 		Root("abc"), Root("yap"), Root("git"),
@@ -17,7 +17,8 @@ func TestParseRoot(t *testing.T) {
 		Root("ççç"), Root("daB"), Root("caC"),
 		Root("saD"), Root("giD"), Root("gök"),
 		Root("göK"), Root("uouou"), Root("vvvrrruuuD"),
-		Root("cac"), Root("buN"), Root("oN"), Root("kaN"),
+		Root("cac"), Root("buN"), Root("oN"), Root("kaN"), Root("çocuK"),
+		Root("istanbul"), Root("ev"), Root("kitap"), Root("ırmak"),
 	}
 	for i, s := range valid {
 		r, ok := ParseRoot(s)
@@ -29,7 +30,7 @@ func TestParseRoot(t *testing.T) {
 	invalid := []string{
 		"aBc", "aCb", "AcB", "öööI", "KaaaaK", "abc(d)", "ya(K)",
 		"yaN", "KiD", "yaK(n)", "yaKn", "aCaK", "AcAK", "(I)a(n)",
-		"(K)oK", "(K)o(n)", "(C)ac",
+		"(K)oK", "(K)o(n)", "(C)ac", "kitaP", "ağaÇ",
 	}
 	for _, s := range invalid {
 		r, ok := ParseRoot(s)
@@ -43,7 +44,7 @@ func TestParseSuffix(t *testing.T) {
 	valid := []string{
 		"abc", "AbC", "(I)n", "(n)In", "(I)m", "   (K)AAAAIIII(n)\t\r\n   ",
 		"(y)AcAK", "sIz", "(s)I(n)", "lIK", "CI", "KI", "lI", "DAş",
-		"(A)KDDCCBB(n)", "(I)AI(n)",
+		"(A)KDDCCBB(n)", "(I)AI(n)", "LAR", "(Y)İm",
 	}
 	valid_out := []Suffix{
 		Suffix{Head: 0, Tail: 0, Body: []rune("abc")},
@@ -62,6 +63,8 @@ func TestParseSuffix(t *testing.T) {
 		Suffix{Head: 0, Tail: 0, Body: []rune("DAş")},
 		Suffix{Head: 'A', Tail: 'n', Body: []rune("KDDCCBB")},
 		Suffix{Head: 'I', Tail: 'n', Body: []rune("AI")},
+		Suffix{Head: 0, Tail: 0, Body: []rune("lAr")},
+		Suffix{Head: 'y', Tail: 0, Body: []rune("im")},
 	}
 
 	for i, s := range valid {
@@ -145,6 +148,7 @@ func TestAppend(t *testing.T) {
 	valid := []string{
 		"tanı (I)ş DIr (I)l (y)AmA  \t\r\n   (y)Abil (y)AcAK lAr DAn (y)mIş çA (s)I(n)  (y)A",
 		"bu(n) lAr  (n)In  ki   lAr    DAn mI  (y)mIş",
+		"kitaB (s)I(n) (y)lA",
 	}
 
 	valid_stems := [][]Stem{
@@ -172,11 +176,17 @@ func TestAppend(t *testing.T) {
 			Stem("bunlarınkilerdenmi"),
 			Stem("bunlarınkilerdenmiymiş"),
 		},
+		[]Stem{
+			Stem("kitaB"),
+			Stem("kitabıN"),
+			Stem("kitabıyla"),
+		},
 	}
 
 	valid_words := []Word{
 		Word("tanıştırılamayabileceklerdenmişçesine"),
 		Word("bunlarınkilerdenmiymiş"),
+		Word("kitabıyla"),
 	}
 
 	for i, s := range valid {
@@ -194,3 +204,53 @@ func TestAppend(t *testing.T) {
 		}
 	}
 }
+
+func TestWord(t *testing.T) {
+	valid := []Stem{
+		Stem("kitaB"), Stem("giD"), Stem("buN"), Stem("evinizdeN"), Stem("geleceK"), Stem("ev"),
+	}
+	valid_words := []Word{
+		Word("kitap"), Word("git"), Word("bu"), Word("evinizde"), Word("gelecek"), Word("ev"),
+	}
+	for i, stem := range valid {
+		word := stem.Word()
+		if !reflect.DeepEqual(word, valid_words[i]) {
+			t.Errorf("(%v).Word() = %#v, expected %#v", stem, word, valid_words[i])
+		}
+	}
+}
+
+func TestEnding(t *testing.T) {
+	valid := []string{
+		"üç DA", "dörD DA", "dörD (y)A", "yedi (I)ncI", "altmış lAr DA", "iki (y)A", "yüz", "ka(n) (y)I",
+	}
+	valid_out := []Word{
+		Word("te"), Word("te"), Word("e"), Word("nci"), Word("larda"), Word("ye"), Word(""), Word("ı"),
+	}
+	for i, s := range valid {
+		root, sufs, _ := ParseRootSuffixes(s)
+		if e := Stem(root).Ending(sufs...); !reflect.DeepEqual(e, valid_out[i]) {
+			t.Errorf("(%v).Ending(%v) = %#v, expected %#v", root, sufs, e, valid_out[i])
+		}
+	}
+}
+
+func TestClass(t *testing.T) {
+	for _, c := range "abcçdefgğhıijklmnoöprsştuüvyzABCDIKN" {
+		if k := class(c); (k&vowel != 0) != Vowel[c] || (k&unvoiced != 0) != voiceless[c] {
+			t.Errorf("class(%q) = %08b, expected vowel %v, voiceless %v", c, k, Vowel[c], voiceless[c])
+		}
+	}
+	for v, q := range vowel_to_quality {
+		if v == 'A' || v == 'I' {
+			continue
+		}
+		h := class(v) & harmony_bits
+		if r := vowel_to_quality[resolve_vowel('I', h)]; r != (quality{q.front, q.round, true}) {
+			t.Errorf("resolve_vowel('I', class(%q)) has quality %v", v, r)
+		}
+		if r := vowel_to_quality[resolve_vowel('A', h)]; r != (quality{q.front, false, false}) {
+			t.Errorf("resolve_vowel('A', class(%q)) has quality %v", v, r)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package normalize
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+/* the combining dot above, which lowercasing İ without Turkish casing leaves after an i (İ -> i + U+0307) */
+const dotAbove = '\u0307'
+
+/*
+Normalizes text to NFC, composing the letters written as a letter followed by a combining mark
+(s + U+0327 -> ş, I + U+0307 -> İ, e + U+0301 -> é). A dot above an i, which NFC keeps since there is
+no such letter, is dropped: it comes from lowercasing İ without Turkish casing.
+*/
+func NFC(s string) string {
+	s = norm.NFC.String(s)
+	if !strings.ContainsRune(s, dotAbove) {
+		return s
+	}
+	return strings.Replace(s, "i"+string(dotAbove), "i", -1)
+}
+
+/* lowercases normalized text with Turkish casing: I -> ı, İ -> i */
+func Lower(s string) string {
+	return strings.ToLowerSpecial(unicode.TurkishCase, NFC(s))
+}
+
+/* uppercases normalized text with Turkish casing: ı -> I, i -> İ */
+func Upper(s string) string {
+	return strings.ToUpperSpecial(unicode.TurkishCase, NFC(s))
+}
+
+/*
+Returns s with the casing of original, as when a word was lowercased for processing and the result should
+be written the way the word was: an uppercase original (with at least two letters) makes s uppercase,
+otherwise each letter of s is uppercased where the letter of original at the same position is.
+(İSTANBUL, istanbul'da) -> İSTANBUL'DA, (Ankara, ankarada) -> Ankarada
+*/
+func Recase(original, s string) string {
+	var upper []bool
+	all := true
+	for _, c := range NFC(original) {
+		if unicode.IsLetter(c) {
+			upper = append(upper, unicode.IsUpper(c))
+			all = all && unicode.IsUpper(c)
+		}
+	}
+	if len(upper) > 1 && all {
+		return Upper(s)
+	}
+	var b strings.Builder
+	i := 0
+	for len(s) > 0 {
+		c, size := utf8.DecodeRuneInString(s)
+		if unicode.IsLetter(c) {
+			if i < len(upper) && upper[i] {
+				b.WriteString(Upper(s[:size]))
+			} else {
+				b.WriteString(s[:size])
+			}
+			i++
+		} else {
+			b.WriteString(s[:size])
+		}
+		s = s[size:]
+	}
+	return b.String()
+}
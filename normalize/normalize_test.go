@@ -0,0 +1,51 @@
+package normalize
+
+import (
+	"testing"
+)
+
+func TestNFC(t *testing.T) {
+	valid := []string{
+		"s\u0327eker", "gu\u0308zel", "I\u0307stanbul", "i\u0307stanbul", "C\u0327ORAP", "ka\u0302r",
+		"c\u0327alıs\u0327ıyorum", "çalışıyorum", "e\u0301", "n\u0303", "",
+	}
+	valid_out := []string{"şeker", "güzel", "İstanbul", "istanbul", "ÇORAP", "kâr", "çalışıyorum", "çalışıyorum", "é", "ñ", ""}
+	for i, s := range valid {
+		if n := NFC(s); n != valid_out[i] {
+			t.Errorf("NFC(%q) = %q, expected %q", s, n, valid_out[i])
+		}
+	}
+}
+
+func TestLower(t *testing.T) {
+	valid := []string{"İstanbul", "IRMAK", "I\u0307zmir", "ŞEKER", "Çalışıyorum", "ev"}
+	valid_out := []string{"istanbul", "ırmak", "izmir", "şeker", "çalışıyorum", "ev"}
+	for i, s := range valid {
+		if l := Lower(s); l != valid_out[i] {
+			t.Errorf("Lower(%s) = %s, expected %s", s, l, valid_out[i])
+		}
+	}
+}
+
+func TestUpper(t *testing.T) {
+	valid := []string{"istanbul", "ırmak", "şeker", "i\u0307"}
+	valid_out := []string{"İSTANBUL", "IRMAK", "ŞEKER", "İ"}
+	for i, s := range valid {
+		if u := Upper(s); u != valid_out[i] {
+			t.Errorf("Upper(%s) = %s, expected %s", s, u, valid_out[i])
+		}
+	}
+}
+
+func TestRecase(t *testing.T) {
+	valid := [][2]string{
+		{"İSTANBUL", "istanbul'da"}, {"Ankara", "ankarada"}, {"Istanbul", "istanbul"}, {"ev", "evde"},
+		{"I", "ı"}, {"eV", "evler"}, {"", "ev"}, {"Calisiyorum", "çalışıyorum"},
+	}
+	valid_out := []string{"İSTANBUL'DA", "Ankarada", "İstanbul", "evde", "I", "eVler", "ev", "Çalışıyorum"}
+	for i, v := range valid {
+		if r := Recase(v[0], v[1]); r != valid_out[i] {
+			t.Errorf("Recase(%s, %s) = %s, expected %s", v[0], v[1], r, valid_out[i])
+		}
+	}
+}
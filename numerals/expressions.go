@@ -0,0 +1,76 @@
+package numerals
+
+import (
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/* the locative that forms the denominator of a fraction: üçte (bir), yüzde (yirmi) */
+var locative = inf.Suffix{Head: 0, Tail: 0, Body: []rune("DA")}
+
+/* appends the suffixes to the last of the words */
+func inflectLast(ws []inf.Stem, sufs []inf.Suffix) []inf.Stem {
+	last := ws[len(ws)-1]
+	for _, suf := range sufs {
+		last = last.Append(suf)
+	}
+	return append(ws[:len(ws)-1:len(ws)-1], last)
+}
+
+/*
+Returns the words of the fraction num/den followed by suffixes, which are read as the denominator in the
+locative and then the numerator: (1, 3) -> üçte bir, (1, 3, (s)I(n)) -> üçte biri, (3, 4, (s)I(n), DAn) -> dörtte üçünden
+*/
+func Fraction(num, den int64, sufs ...inf.Suffix) string {
+	return join(append(inflectLast(signed(den), []inf.Suffix{locative}), inflectLast(signed(num), sufs)...))
+}
+
+/*
+Returns the words of n percent followed by suffixes, a fraction of a hundred:
+20 -> yüzde yirmi, (20, (s)I(n)) -> yüzde yirmisi
+*/
+func PercentWords(n int64, sufs ...inf.Suffix) string {
+	return Fraction(n, 100, sufs...)
+}
+
+/*
+Writes a percentage of a number written with digits (see Pronounce) followed by suffixes, which harmonize
+with the number as it is pronounced: (20, (s)I(n)) -> %20'si, (50, (y)A) -> %50'ye, (3,5) -> %3,5
+*/
+func Percent(digits string, sufs ...inf.Suffix) (string, bool) {
+	w, ok := Inflect(digits, sufs...)
+	if !ok {
+		return "", false
+	}
+	return "%" + w, true
+}
+
+/*
+Writes the fraction num/den of numbers written with digits followed by suffixes. Since the fraction is read
+with the numerator last, the suffixes harmonize with it: (1, 3, (s)I(n)) -> 1/3'i, read üçte biri
+*/
+func FractionDigits(num, den string, sufs ...inf.Suffix) (string, bool) {
+	last, ok := LastWord(num)
+	if _, dok := pronounce(den); !ok || !dok {
+		return "", false
+	}
+	w := num + "/" + den
+	if e := last.Ending(sufs...); len(e) > 0 {
+		w += "'" + e.String()
+	}
+	return w, true
+}
+
+/*
+Writes the range from-to of numbers written with digits followed by suffixes, which harmonize with the
+last number as it is pronounced: (5, 10, (y)I) -> 5-10'u (arasında), (1990, 2000, DA) -> 1990-2000'de
+*/
+func Range(from, to string, sufs ...inf.Suffix) (string, bool) {
+	if _, ok := pronounce(from); !ok {
+		return "", false
+	}
+	w, ok := Inflect(to, sufs...)
+	if !ok {
+		return "", false
+	}
+	return from + "-" + w, true
+}
@@ -0,0 +1,85 @@
+package numerals
+
+import (
+	"testing"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+func TestFraction(t *testing.T) {
+	valid := [][2]int64{{1, 3}, {1, 3}, {3, 4}, {2, 5}, {1, 2}, {20, 100}}
+	valid_sufs := [][]inf.Suffix{
+		nil, suffixes("(s)I(n)"), suffixes("(s)I(n)", "DAn"), suffixes("(s)I(n)", "(n)In"), nil, suffixes("(s)I(n)"),
+	}
+	valid_out := []string{"üçte bir", "üçte biri", "dörtte üçünden", "beşte ikisinin", "ikide bir", "yüzde yirmisi"}
+	for i, f := range valid {
+		if w := Fraction(f[0], f[1], valid_sufs[i]...); w != valid_out[i] {
+			t.Errorf("Fraction(%d, %d, %v) = %s, expected %s", f[0], f[1], valid_sufs[i], w, valid_out[i])
+		}
+	}
+}
+
+func TestPercentWords(t *testing.T) {
+	valid := []int64{20, 50, 100, 4}
+	valid_sufs := [][]inf.Suffix{nil, suffixes("(s)I(n)"), suffixes("(y)A"), suffixes("(s)I(n)", "DA")}
+	valid_out := []string{"yüzde yirmi", "yüzde ellisi", "yüzde yüze", "yüzde dördünde"}
+	for i, n := range valid {
+		if w := PercentWords(n, valid_sufs[i]...); w != valid_out[i] {
+			t.Errorf("PercentWords(%d, %v) = %s, expected %s", n, valid_sufs[i], w, valid_out[i])
+		}
+	}
+}
+
+func TestPercent(t *testing.T) {
+	valid := []string{"20", "50", "3,5", "100", "40", "20"}
+	valid_sufs := [][]inf.Suffix{
+		suffixes("(s)I(n)"), suffixes("(y)A"), nil, suffixes("DAn"), suffixes("(s)I(n)", "DAn"),
+		suffixes("(s)I(n)", "(y)lA"),
+	}
+	valid_out := []string{"%20'si", "%50'ye", "%3,5", "%100'den", "%40'ından", "%20'siyle"}
+	for i, d := range valid {
+		if w, ok := Percent(d, valid_sufs[i]...); !ok || w != valid_out[i] {
+			t.Errorf("Percent(%s, %v) = (%s, %v), expected (%s, true)", d, valid_sufs[i], w, ok, valid_out[i])
+		}
+	}
+
+	if w, ok := Percent("%20"); ok {
+		t.Errorf("Percent(%%20) = %s, expected to fail", w)
+	}
+}
+
+func TestFractionDigits(t *testing.T) {
+	valid := [][2]string{{"1", "3"}, {"3", "4"}, {"2", "5"}}
+	valid_sufs := [][]inf.Suffix{suffixes("(s)I(n)"), suffixes("(s)I(n)", "DA"), nil}
+	valid_out := []string{"1/3'i", "3/4'ünde", "2/5"}
+	for i, f := range valid {
+		if w, ok := FractionDigits(f[0], f[1], valid_sufs[i]...); !ok || w != valid_out[i] {
+			t.Errorf("FractionDigits(%s, %s, %v) = (%s, %v), expected (%s, true)", f[0], f[1], valid_sufs[i], w, ok, valid_out[i])
+		}
+	}
+
+	invalid := [][2]string{{"1", ""}, {"x", "3"}, {"1", "3,"}}
+	for _, f := range invalid {
+		if w, ok := FractionDigits(f[0], f[1]); ok {
+			t.Errorf("FractionDigits(%s, %s) = %s, expected to fail", f[0], f[1], w)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	valid := [][2]string{{"5", "10"}, {"1990", "2000"}, {"3", "4"}, {"1,5", "2,5"}}
+	valid_sufs := [][]inf.Suffix{suffixes("(y)I"), suffixes("DA"), suffixes("DAn"), nil}
+	valid_out := []string{"5-10'u", "1990-2000'de", "3-4'ten", "1,5-2,5"}
+	for i, r := range valid {
+		if w, ok := Range(r[0], r[1], valid_sufs[i]...); !ok || w != valid_out[i] {
+			t.Errorf("Range(%s, %s, %v) = (%s, %v), expected (%s, true)", r[0], r[1], valid_sufs[i], w, ok, valid_out[i])
+		}
+	}
+
+	invalid := [][2]string{{"", "10"}, {"5", ""}, {"a", "b"}}
+	for _, r := range invalid {
+		if w, ok := Range(r[0], r[1]); ok {
+			t.Errorf("Range(%s, %s) = %s, expected to fail", r[0], r[1], w)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package numerals
+
+import (
+	"strings"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/* the suffix that makes a measure adjective of a unit: metrelik, günlük */
+var measure = inf.Suffix{Head: 0, Tail: 0, Body: []rune("lIK")}
+
+/* the suffix that makes an adjective of a noun: renkli */
+var with = inf.Suffix{Head: 0, Tail: 0, Body: []rune("lI")}
+
+/* the adjective of a number of colours or of a colour, which modifies a noun: renkli */
+var colored = inf.Stem([]rune("renk")).Append(with).Word().String()
+
+/* returns the measure adjective of a unit, a root as parsed by inflection.ParseRoot: metre -> metrelik */
+func measureOf(unit string) (string, bool) {
+	root, ok := inf.ParseRoot(unit)
+	if !ok {
+		return "", false
+	}
+	return inf.Stem(root).Append(measure).Word().String(), true
+}
+
+/*
+Returns the measure adjective of n units, which modifies a noun to form a measure phrase:
+(2, metre) -> iki metrelik (kablo), (3, gün) -> üç günlük (gezi). The unit stays singular after the number.
+*/
+func Measure(n int64, unit string) (string, bool) {
+	m, ok := measureOf(unit)
+	if !ok {
+		return "", false
+	}
+	return Words(n) + " " + m, true
+}
+
+/*
+Returns the measure adjective of a number written with digits (see Pronounce) and a unit:
+(2, metre) -> 2 metrelik, (1,5, litre) -> 1,5 litrelik, (500, gram) -> 500 gramlık
+*/
+func MeasureDigits(digits, unit string) (string, bool) {
+	if _, ok := pronounce(digits); !ok {
+		return "", false
+	}
+	m, ok := measureOf(unit)
+	if !ok {
+		return "", false
+	}
+	return digits + " " + m, true
+}
+
+/*
+Returns the colour adjective of a colour, which modifies a noun as measure adjectives do:
+kırmızı -> kırmızı renkli (tişört), açık mavi -> açık mavi renkli (gömlek). Every word of the colour must be
+a root as parsed by inflection.ParseRoot.
+*/
+func Color(color string) (string, bool) {
+	words := strings.Fields(color)
+	if len(words) == 0 {
+		return "", false
+	}
+	for _, w := range words {
+		if _, ok := inf.ParseRoot(w); !ok {
+			return "", false
+		}
+	}
+	return strings.Join(words, " ") + " " + colored, true
+}
+
+/* Returns the adjective of n colours, which modifies a noun: 2 -> iki renkli (kazak), 4 -> dört renkli (baskı) */
+func Colors(n int64) string {
+	return Words(n) + " " + colored
+}
@@ -0,0 +1,65 @@
+package numerals
+
+import (
+	"testing"
+)
+
+func TestMeasure(t *testing.T) {
+	valid := []int64{2, 3, 1, 10, 24, 100}
+	units := []string{"metre", "gün", "hafta", "dakika", "kilo", "yıl"}
+	valid_out := []string{"iki metrelik", "üç günlük", "bir haftalık", "on dakikalık", "yirmi dört kiloluk", "yüz yıllık"}
+	for i, n := range valid {
+		if m, ok := Measure(n, units[i]); !ok || m != valid_out[i] {
+			t.Errorf("Measure(%d, %s) = %s, %v, expected %s", n, units[i], m, ok, valid_out[i])
+		}
+	}
+
+	if m, ok := Measure(2, "m3tre"); ok {
+		t.Errorf("Measure(2, m3tre) = %s, expected to fail", m)
+	}
+}
+
+func TestMeasureDigits(t *testing.T) {
+	valid := []string{"2", "1,5", "500", "1.000", "3"}
+	units := []string{"metre", "litre", "gram", "kilometre", "ay"}
+	valid_out := []string{"2 metrelik", "1,5 litrelik", "500 gramlık", "1.000 kilometrelik", "3 aylık"}
+	for i, d := range valid {
+		if m, ok := MeasureDigits(d, units[i]); !ok || m != valid_out[i] {
+			t.Errorf("MeasureDigits(%s, %s) = %s, %v, expected %s", d, units[i], m, ok, valid_out[i])
+		}
+	}
+
+	invalid := [][2]string{{"2,", "metre"}, {"", "metre"}, {"1.00", "gram"}, {"2", ""}}
+	for _, v := range invalid {
+		if m, ok := MeasureDigits(v[0], v[1]); ok {
+			t.Errorf("MeasureDigits(%s, %s) = %s, expected to fail", v[0], v[1], m)
+		}
+	}
+}
+
+func TestColor(t *testing.T) {
+	valid := []string{"kırmızı", "açık mavi", "  koyu   yeşil ", "lacivert"}
+	valid_out := []string{"kırmızı renkli", "açık mavi renkli", "koyu yeşil renkli", "lacivert renkli"}
+	for i, c := range valid {
+		if s, ok := Color(c); !ok || s != valid_out[i] {
+			t.Errorf("Color(%s) = %s, %v, expected %s", c, s, ok, valid_out[i])
+		}
+	}
+
+	invalid := []string{"", " ", "k1rmızı", "açık m4vi"}
+	for _, c := range invalid {
+		if s, ok := Color(c); ok {
+			t.Errorf("Color(%s) = %s, expected to fail", c, s)
+		}
+	}
+}
+
+func TestColors(t *testing.T) {
+	valid := []int64{1, 2, 4, 16}
+	valid_out := []string{"bir renkli", "iki renkli", "dört renkli", "on altı renkli"}
+	for i, n := range valid {
+		if s := Colors(n); s != valid_out[i] {
+			t.Errorf("Colors(%d) = %s, expected %s", n, s, valid_out[i])
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package numerals
+
+import (
+	"strconv"
+	"strings"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/* the words for digits and tens, as stems so that their final consonant mutates (dörD: dört, dördüncü) */
+var ones = []inf.Stem{
+	nil, inf.Stem("bir"), inf.Stem("iki"), inf.Stem("üç"), inf.Stem("dörD"),
+	inf.Stem("beş"), inf.Stem("altı"), inf.Stem("yedi"), inf.Stem("sekiz"), inf.Stem("dokuz"),
+}
+
+var tens = []inf.Stem{
+	nil, inf.Stem("on"), inf.Stem("yirmi"), inf.Stem("otuz"), inf.Stem("kırk"),
+	inf.Stem("elli"), inf.Stem("altmış"), inf.Stem("yetmiş"), inf.Stem("seksen"), inf.Stem("doksan"),
+}
+
+var (
+	zero    = inf.Stem("sıfır")
+	hundred = inf.Stem("yüz")
+	minus   = inf.Stem("eksi")
+	comma   = inf.Stem("virgül")
+)
+
+/* the powers of a thousand */
+var scales = []inf.Stem{
+	nil, inf.Stem("bin"), inf.Stem("milyon"), inf.Stem("milyar"), inf.Stem("trilyon"),
+	inf.Stem("katrilyon"), inf.Stem("kentilyon"),
+}
+
+/* the ordinal suffix: yedinci, dördüncü */
+var ordinal = inf.Suffix{Head: 'I', Tail: 0, Body: []rune("ncI")}
+
+/* returns the words of a non-negative integer */
+func stems(n uint64) []inf.Stem {
+	if n == 0 {
+		return []inf.Stem{zero}
+	}
+	var groups [][]inf.Stem
+	for scale := 0; n > 0; scale++ {
+		g := n % 1000
+		n /= 1000
+		if g == 0 {
+			continue
+		}
+		var ws []inf.Stem
+		/* one hundred and one thousand are yüz and bin, not bir yüz and bir bin */
+		if h := g / 100; h > 1 {
+			ws = append(ws, ones[h], hundred)
+		} else if h == 1 {
+			ws = append(ws, hundred)
+		}
+		if t := g / 10 % 10; t > 0 {
+			ws = append(ws, tens[t])
+		}
+		if o := g % 10; o > 0 && !(g == 1 && scale == 1) {
+			ws = append(ws, ones[o])
+		}
+		if scale > 0 {
+			ws = append(ws, scales[scale])
+		}
+		groups = append([][]inf.Stem{ws}, groups...)
+	}
+	var ws []inf.Stem
+	for _, g := range groups {
+		ws = append(ws, g...)
+	}
+	return ws
+}
+
+func join(ws []inf.Stem) string {
+	s := make([]string, len(ws))
+	for i, w := range ws {
+		s[i] = w.Word().String()
+	}
+	return strings.Join(s, " ")
+}
+
+func signed(n int64) []inf.Stem {
+	if n < 0 {
+		/* the negation is done unsigned so that the minimum int64 does not overflow */
+		return append([]inf.Stem{minus}, stems(uint64(-(n+1))+1)...)
+	}
+	return stems(uint64(n))
+}
+
+/* returns the Turkish words of an integer: 1453 -> bin dört yüz elli üç */
+func Words(n int64) string {
+	return join(signed(n))
+}
+
+/* returns the Turkish words of the ordinal of an integer: 7 -> yedinci, 1453 -> bin dört yüz elli üçüncü */
+func Ordinal(n int64) string {
+	ws := signed(n)
+	ws[len(ws)-1] = ws[len(ws)-1].Append(ordinal)
+	return join(ws)
+}
+
+/*
+Parses a number as Turkish writes it: digits with an optional leading -, dots separating thousands and
+a comma before the decimals (1.500.000, -3,25). Returns its words: a decimal is read as the integer part,
+virgül, and the decimal digits as an integer with each leading zero read as sıfır (3,05 -> üç virgül sıfır beş).
+*/
+func pronounce(digits string) (ws []inf.Stem, ok bool) {
+	neg := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+	ip, fp := digits, ""
+	if i := strings.IndexByte(digits, ','); i >= 0 {
+		ip, fp = digits[:i], digits[i+1:]
+		if fp == "" {
+			return nil, false
+		}
+	}
+	if groups := strings.Split(ip, "."); len(groups) > 1 {
+		for i, g := range groups {
+			if len(g) != 3 && !(i == 0 && len(g) >= 1 && len(g) <= 3) {
+				return nil, false
+			}
+		}
+		ip = strings.Join(groups, "")
+	}
+	n, err := strconv.ParseUint(ip, 10, 64)
+	if err != nil || ip[0] == '+' {
+		return nil, false
+	}
+	if neg {
+		ws = append(ws, minus)
+	}
+	ws = append(ws, stems(n)...)
+	if fp != "" {
+		ws = append(ws, comma)
+		for len(fp) > 1 && fp[0] == '0' {
+			ws = append(ws, zero)
+			fp = fp[1:]
+		}
+		f, err := strconv.ParseUint(fp, 10, 64)
+		if err != nil || fp[0] == '+' {
+			return nil, false
+		}
+		ws = append(ws, stems(f)...)
+	}
+	return ws, true
+}
+
+/* returns the words of a number written with digits (see pronounce): 1.453 -> bin dört yüz elli üç */
+func Pronounce(digits string) (string, bool) {
+	ws, ok := pronounce(digits)
+	if !ok {
+		return "", false
+	}
+	return join(ws), true
+}
+
+/*
+Returns the last word of a number written with digits (see pronounce) as a stem. Suffixes written after
+the number harmonize with it: 1960 -> altmış
+*/
+func LastWord(digits string) (inf.Stem, bool) {
+	ws, ok := pronounce(digits)
+	if !ok {
+		return nil, false
+	}
+	return ws[len(ws)-1], true
+}
+
+/*
+Writes suffixes after a number written with digits the way Turkish does: separated by an apostrophe and
+in harmony with the last word of the number as it is pronounced.
+3 + DA -> 3'te, 7 + (I)ncI -> 7'nci, 1960 + lAr + DA -> 1960'larda
+*/
+func Inflect(digits string, sufs ...inf.Suffix) (string, bool) {
+	last, ok := LastWord(digits)
+	if !ok {
+		return "", false
+	}
+	e := last.Ending(sufs...)
+	if len(e) == 0 {
+		return digits, true
+	}
+	return digits + "'" + e.String(), true
+}
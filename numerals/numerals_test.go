@@ -0,0 +1,86 @@
+package numerals
+
+import (
+	"math"
+	"testing"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+func TestWords(t *testing.T) {
+	valid := []int64{0, 1, 4, 10, 14, 100, 101, 111, 1000, 1001, 1453, 2000, 10000, 100000, 1000000, 1960, -40, math.MinInt64}
+	valid_out := []string{
+		"sıfır", "bir", "dört", "on", "on dört", "yüz", "yüz bir", "yüz on bir", "bin", "bin bir",
+		"bin dört yüz elli üç", "iki bin", "on bin", "yüz bin", "bir milyon", "bin dokuz yüz altmış", "eksi kırk",
+		"eksi dokuz kentilyon iki yüz yirmi üç katrilyon üç yüz yetmiş iki trilyon otuz altı milyar " +
+			"sekiz yüz elli dört milyon yedi yüz yetmiş beş bin sekiz yüz sekiz",
+	}
+	for i, n := range valid {
+		if w := Words(n); w != valid_out[i] {
+			t.Errorf("Words(%d) = %s, expected %s", n, w, valid_out[i])
+		}
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	valid := []int64{1, 2, 3, 4, 6, 10, 40, 100, 1000, 1453}
+	valid_out := []string{
+		"birinci", "ikinci", "üçüncü", "dördüncü", "altıncı", "onuncu", "kırkıncı", "yüzüncü", "bininci",
+		"bin dört yüz elli üçüncü",
+	}
+	for i, n := range valid {
+		if w := Ordinal(n); w != valid_out[i] {
+			t.Errorf("Ordinal(%d) = %s, expected %s", n, w, valid_out[i])
+		}
+	}
+}
+
+func TestPronounce(t *testing.T) {
+	valid := []string{"1.453", "1453", "3,5", "3,05", "-2", "1.000.000", "0,25"}
+	valid_out := []string{
+		"bin dört yüz elli üç", "bin dört yüz elli üç", "üç virgül beş", "üç virgül sıfır beş", "eksi iki",
+		"bir milyon", "sıfır virgül yirmi beş",
+	}
+	for i, s := range valid {
+		if w, ok := Pronounce(s); !ok || w != valid_out[i] {
+			t.Errorf("Pronounce(%s) = (%s, %v), expected (%s, %v)", s, w, ok, valid_out[i], true)
+		}
+	}
+
+	invalid := []string{"", "abc", "1.45", "3,", ",5", "1..000", "+3", "3,+5", "12.34.567"}
+	for _, s := range invalid {
+		if w, ok := Pronounce(s); ok {
+			t.Errorf("Pronounce(%s) = (%s, %v), expected (%s, %v)", s, w, ok, "", false)
+		}
+	}
+}
+
+func suffixes(ss ...string) []inf.Suffix {
+	var sufs []inf.Suffix
+	for _, s := range ss {
+		suf, _ := inf.ParseSuffix(s)
+		sufs = append(sufs, suf)
+	}
+	return sufs
+}
+
+func TestInflect(t *testing.T) {
+	valid := []string{"3", "7", "1960", "4", "4", "2", "10", "100", "6", "1.000.000", "3,5", "5"}
+	valid_sufs := [][]inf.Suffix{
+		suffixes("DA"), suffixes("(I)ncI"), suffixes("lAr", "DA"), suffixes("(y)A"), suffixes("DAn"),
+		suffixes("(y)I"), suffixes("(n)In"), suffixes("(y)lA"), suffixes("(s)I(n)", "DA"), suffixes("DA"),
+		suffixes("(y)I"), suffixes(""),
+	}
+	valid_out := []string{
+		"3'te", "7'nci", "1960'larda", "4'e", "4'ten", "2'yi", "10'un", "100'le", "6'sında", "1.000.000'da",
+		"3,5'i", "5",
+	}
+	for i, s := range valid {
+		if w, ok := Inflect(s, valid_sufs[i]...); !ok || w != valid_out[i] {
+			t.Errorf("Inflect(%s, %v) = (%s, %v), expected (%s, %v)", s, valid_sufs[i], w, ok, valid_out[i], true)
+		}
+	}
+	if w, ok := Inflect("x", suffixes("DA")...); ok {
+		t.Errorf("Inflect(x, DA) = (%s, %v), expected (%s, %v)", w, ok, "", false)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+)
+
+/*
+paradigm [-data DIR] [-order textbook|catalog] LEMMA POS SLOT...
+Prints the paradigm of a root of the lexicon (see Analyzer.Paradigm), one form and its analysis per line:
+
+	paradigm gel VERB TAM.PPFV.KNWN VB
+	paradigm ev NOUN PL? CASE
+*/
+func paradigmCmd(args []string) {
+	fs := flag.NewFlagSet("paradigm", flag.ExitOnError)
+	data := fs.String("data", ".", "directory of the data files")
+	order := fs.String("order", "textbook", "order of the forms: textbook or catalog")
+	fs.Parse(args)
+	if fs.NArg() < 3 {
+		fatal(fmt.Errorf("usage: paradigm [-data DIR] [-order textbook|catalog] LEMMA POS SLOT..."))
+	}
+	orders := map[string]analysis.Order{"textbook": analysis.Textbook, "catalog": analysis.CatalogOrder}
+	o, ok := orders[*order]
+	if !ok {
+		fatal(fmt.Errorf("unknown order %q", *order))
+	}
+
+	an, err := analysis.Load(*data)
+	if err != nil {
+		fatal(err)
+	}
+	es := an.Entries(fs.Arg(0), fs.Arg(1))
+	if len(es) == 0 {
+		fatal(fmt.Errorf("%s[%s] is not in the lexicon", fs.Arg(0), fs.Arg(1)))
+	}
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	for _, e := range es {
+		for _, a := range an.Paradigm(e, o, fs.Args()[2:]...) {
+			fmt.Fprintf(out, "%s\t%s\n", a.Word, a)
+		}
+	}
+}
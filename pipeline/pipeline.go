@@ -0,0 +1,133 @@
+package pipeline
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+	"github.com/kaan9/turkish-morphology/normalize"
+	"github.com/kaan9/turkish-morphology/tokenize"
+)
+
+/*
+A Token is a word or number of a sentence with its chosen analysis (nil if the word could not be analyzed),
+its lemma, and whether the lemma is a stopword. The lemma of a number is its digits.
+A reduplicated converb, written as two words (koşa koşa) or hyphenated (koşa-koşa), is a single Reduplicated
+token with both words as its Surface and the analysis of one of them: koş[VERB]+CVB.V.1.
+*/
+type Token struct {
+	Surface      string
+	Analysis     *analysis.Analysis
+	Lemma        string
+	Stop         bool
+	Reduplicated bool
+}
+
+/* A Sentence holds its text, all of its tokens, and the lemmas of the tokens that are not stopwords */
+type Sentence struct {
+	Text   string
+	Tokens []Token
+	Lemmas []string
+}
+
+/*
+A Pipeline bundles the preprocessing stages most applications need:
+segment -> tokenize -> analyze -> disambiguate -> lemmatize -> stopword-filter
+Disambiguation chooses the best analysis of Analyzer.Rank, which uses the Weights of the analyzer if set.
+*/
+type Pipeline struct {
+	Analyzer  *analysis.Analyzer
+	Stopwords map[string]bool
+}
+
+/* creates a pipeline using the analyzer and the default Stopwords */
+func New(an *analysis.Analyzer) *Pipeline {
+	return &Pipeline{Analyzer: an, Stopwords: Stopwords}
+}
+
+/* runs all stages of the pipeline on text */
+func (p *Pipeline) Process(text string) []Sentence {
+	var sents []Sentence
+	for _, s := range segment(text) {
+		sent := Sentence{Text: s}
+		toks := tokenize.Tokenize(s)
+		for i := 0; i < len(toks); i++ {
+			t := toks[i]
+			if t.Kind == tokenize.Punct {
+				continue
+			}
+			if i+1 < len(toks) && p.reduplicated(s, t, toks[i+1]) {
+				t = tokenize.Join(s, t, toks[i+1])
+				i++
+			}
+			tok := Token{Surface: t.Text}
+			if ss := p.Analyzer.Rank(p.Analyzer.AnalyzeWritten(t.Text)); len(ss) > 0 {
+				tok.Analysis = &ss[0].Analysis
+				tok.Reduplicated = strings.ContainsAny(t.Text, "- ") && converb(*tok.Analysis)
+			}
+			if tok.Analysis != nil && t.Kind != tokenize.Number {
+				tok.Lemma = tok.Analysis.Lemma()
+			} else {
+				tok.Lemma = normalize.Lower(t.Stem)
+			}
+			tok.Stop = p.Stopwords[tok.Lemma]
+			if !tok.Stop {
+				sent.Lemmas = append(sent.Lemmas, tok.Lemma)
+			}
+			sent.Tokens = append(sent.Tokens, tok)
+		}
+		sents = append(sents, sent)
+	}
+	return sents
+}
+
+/* reports whether the tokens a and b of s are a reduplicated converb written as two words: koşa koşa */
+func (p *Pipeline) reduplicated(s string, a, b tokenize.Token) bool {
+	if !tokenize.Reduplication(s, a, b) {
+		return false
+	}
+	for _, x := range p.Analyzer.Analyze(a.Form()) {
+		if converb(x) {
+			return true
+		}
+	}
+	return false
+}
+
+func converb(a analysis.Analysis) bool {
+	return len(a.Tags) != 0 && a.Tags[len(a.Tags)-1] == analysis.Converb
+}
+
+/*
+splits text into sentences after runs of ., ! and ? (and their closing quotes) that are followed by
+whitespace, and at blank lines
+*/
+func segment(text string) []string {
+	var sents []string
+	r := []rune(text)
+	start := 0
+	for i := 0; i < len(r); i++ {
+		end := -1
+		if r[i] == '.' || r[i] == '!' || r[i] == '?' || r[i] == '…' {
+			j := i + 1
+			for j < len(r) && strings.ContainsRune(".!?…\"'”’)", r[j]) {
+				j++
+			}
+			if j == len(r) || unicode.IsSpace(r[j]) {
+				end = j
+			}
+		} else if r[i] == '\n' && i+1 < len(r) && r[i+1] == '\n' {
+			end = i
+		}
+		if end >= 0 {
+			if s := strings.TrimSpace(string(r[start:end])); s != "" {
+				sents = append(sents, s)
+			}
+			start, i = end, end
+		}
+	}
+	if s := strings.TrimSpace(string(r[start:])); s != "" {
+		sents = append(sents, s)
+	}
+	return sents
+}
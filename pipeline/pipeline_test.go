@@ -0,0 +1,94 @@
+package pipeline
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+)
+
+func TestSegment(t *testing.T) {
+	valid := []string{
+		"Eve geldim. Kitabı okudum!  Sen ne yaptın?",
+		"Bekle... Geliyorum\n\nYeni paragraf",
+		"\"Geldim.\" dedi. 3.5 kilo",
+		"",
+	}
+	valid_out := [][]string{
+		[]string{"Eve geldim.", "Kitabı okudum!", "Sen ne yaptın?"},
+		[]string{"Bekle...", "Geliyorum", "Yeni paragraf"},
+		[]string{"\"Geldim.\"", "dedi.", "3.5 kilo"},
+		nil,
+	}
+	for i, s := range valid {
+		if sents := segment(s); !reflect.DeepEqual(sents, valid_out[i]) {
+			t.Errorf("segment(%q) = %q, expected %q", s, sents, valid_out[i])
+		}
+	}
+}
+
+func TestProcess(t *testing.T) {
+	an, err := analysis.Load("..")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	p := New(an)
+	sents := p.Process("İstanbul'da 3 çocuk ve öğrenciler kitapları okudular. Bu evde mi yaşıyorsun?")
+
+	lemmas := [][]string{
+		[]string{"istanbul", "3", "çocuk", "öğrenci", "kitap", "oku"},
+		[]string{"ev", "yaşa"},
+	}
+	if len(sents) != len(lemmas) {
+		t.Fatalf("Process() = %d sentences, expected %d", len(sents), len(lemmas))
+	}
+	for i, s := range sents {
+		if !reflect.DeepEqual(s.Lemmas, lemmas[i]) {
+			t.Errorf("Process() sentence %d lemmas = %v, expected %v", i, s.Lemmas, lemmas[i])
+		}
+	}
+
+	if a := sents[0].Tokens[0].Analysis; a == nil || a.String() != "istanbul[NOUN]+CASE.LOC" {
+		t.Errorf("Process() analysis of İstanbul'da = %v, expected istanbul[NOUN]+CASE.LOC", a)
+	}
+	if a := sents[0].Tokens[1].Analysis; a == nil || a.String() != "üç[NUM]+CASE.ABSL" {
+		t.Errorf("Process() analysis of 3 = %v, expected üç[NUM]+CASE.ABSL", a)
+	}
+	sents = p.Process("Ankarada 2nci kez.")
+	if v := sents[0].Tokens[0].Analysis.Variant; v == nil || v.Standard != "Ankara'da" {
+		t.Errorf("Process() variant of Ankarada = %+v, expected Ankara'da", v)
+	}
+	if v := sents[0].Tokens[1].Analysis.Variant; v == nil || v.Standard != "2'nci" || sents[0].Tokens[1].Lemma != "2" {
+		t.Errorf("Process() token 2nci = %+v, expected the lemma 2 and the variant 2'nci", sents[0].Tokens[1])
+	}
+
+	sents = p.Process("Bu evde mi yaşıyorsun?")
+	tok := sents[0].Tokens[3]
+	if tok.Surface != "yaşıyorsun" || tok.Analysis == nil ||
+		tok.Analysis.String() != "yaşa[VERB]+TAM.PRS.IPFV+PRED.2sg" || tok.Stop {
+		t.Errorf("Process() token = %#v, expected yaşıyorsun analyzed as yaşa[VERB]+TAM.PRS.IPFV+PRED.2sg", tok)
+	}
+}
+
+func TestProcessReduplication(t *testing.T) {
+	an, err := analysis.Load("..")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	sents := New(an).Process("Çocuklar koşa koşa geldi, güle-güle gittiler. Ev ev baktık.")
+	valid_out := [][]string{{"çocuk", "koş", "gel", "gül", "git"}, {"ev", "ev", "bak"}}
+	for i, s := range sents {
+		if !reflect.DeepEqual(s.Lemmas, valid_out[i]) {
+			t.Errorf("Process() sentence %d lemmas = %v, expected %v", i, s.Lemmas, valid_out[i])
+		}
+	}
+	if tok := sents[0].Tokens[1]; tok.Surface != "koşa koşa" || !tok.Reduplicated || tok.Analysis.String() != "koş[VERB]+CVB.V.1" {
+		t.Errorf("Process() token = %+v, expected the reduplicated koşa koşa", tok)
+	}
+	if tok := sents[0].Tokens[3]; tok.Surface != "güle-güle" || !tok.Reduplicated {
+		t.Errorf("Process() token = %+v, expected the reduplicated güle-güle", tok)
+	}
+	if tok := sents[1].Tokens[0]; tok.Reduplicated {
+		t.Errorf("Process() token = %+v, expected ev not to be reduplicated", tok)
+	}
+}
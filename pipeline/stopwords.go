@@ -0,0 +1,24 @@
+package pipeline
+
+/* default set of stopwords, given as lemmas */
+var Stopwords = map[string]bool{
+	/* conjunctions */
+	"ama": true, "ancak": true, "çünkü": true, "da": true, "de": true, "eğer": true, "fakat": true,
+	"hem": true, "ki": true, "ve": true, "veya": true, "ya": true,
+
+	/* postpositions */
+	"gibi": true, "göre": true, "için": true, "ile": true, "kadar": true,
+
+	/* pronouns and determiners */
+	"ben": true, "sen": true, "o": true, "biz": true, "siz": true, "bu": true, "şu": true,
+	"kendi": true, "bazı": true, "her": true, "hiçbir": true, "bir": true, "şey": true,
+
+	/* adverbs */
+	"çok": true, "daha": true, "en": true, "hep": true, "hiç": true, "artık": true, "henüz": true,
+
+	/* the interrogative particle */
+	"mi": true, "mı": true, "mu": true, "mü": true,
+
+	/* auxiliary verb */
+	"ol": true,
+}
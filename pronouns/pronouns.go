@@ -0,0 +1,54 @@
+package pronouns
+
+import (
+	"sort"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/*
+The suppletive forms of the personal pronouns, which the rules of suffixation cannot produce, by lemma and
+the tag of the suffix attached to the pronoun: ben + (y)A is bana and not bene, biz + (n)In is bizim and
+not bizin. A suppletive form replaces the regular form in analysis and generation.
+The other forms of the personal pronouns are regular (beni, bende, sizin), and the demonstratives bu, şu and o
+are regular with their optional n (see inflection.ParseRoot): o(n) + lAr, (y)A, DA -> onlar, ona, onda.
+*/
+var Suppletive = map[string]map[string]inf.Stem{
+	"ben": {"CASE.DAT": inf.Stem("bana"), "CASE.GEN": inf.Stem("benim")},
+	"sen": {"CASE.DAT": inf.Stem("sana")},
+	"biz": {"CASE.GEN": inf.Stem("bizim")},
+}
+
+/*
+The pronouns that take the genitive before the instrumental -(y)lA, the postposition ile written as a suffix:
+benimle, seninle, onunla, bizimle, bununla, kiminle, where nouns and the other pronouns take it directly
+(evle, neyle, kendiyle) and the plurals are regular (onlarla).
+*/
+var Genitive = map[string]bool{
+	"ben": true, "sen": true, "o": true, "biz": true, "siz": true, "bu": true, "şu": true, "kim": true,
+}
+
+/* reports whether a pronoun takes the genitive before the suffix with the tag */
+func TakesGenitive(lemma, tag string) bool {
+	return tag == "CASE.INS" && Genitive[lemma]
+}
+
+/* returns the suppletive form of a pronoun followed by the suffix with the tag, false if it is regular */
+func Form(lemma, tag string) (inf.Stem, bool) {
+	s, ok := Suppletive[lemma][tag]
+	return s, ok
+}
+
+/* returns the suppletive forms of a pronoun in the sorted order of their tags */
+func Forms(lemma string) []inf.Stem {
+	var tags []string
+	for tag := range Suppletive[lemma] {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	var stems []inf.Stem
+	for _, tag := range tags {
+		stems = append(stems, Suppletive[lemma][tag])
+	}
+	return stems
+}
@@ -0,0 +1,45 @@
+package pronouns
+
+import (
+	"reflect"
+	"testing"
+
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+func TestForm(t *testing.T) {
+	valid := [][2]string{{"ben", "CASE.DAT"}, {"sen", "CASE.DAT"}, {"ben", "CASE.GEN"}, {"biz", "CASE.GEN"}}
+	valid_out := []inf.Stem{inf.Stem("bana"), inf.Stem("sana"), inf.Stem("benim"), inf.Stem("bizim")}
+	for i, v := range valid {
+		if s, ok := Form(v[0], v[1]); !ok || !reflect.DeepEqual(s, valid_out[i]) {
+			t.Errorf("Form(%s, %s) = (%v, %v), expected (%v, true)", v[0], v[1], s, ok, valid_out[i])
+		}
+	}
+
+	invalid := [][2]string{{"ben", "CASE.ACC"}, {"sen", "CASE.GEN"}, {"o", "CASE.DAT"}, {"ev", "CASE.DAT"}}
+	for _, v := range invalid {
+		if s, ok := Form(v[0], v[1]); ok {
+			t.Errorf("Form(%s, %s) = (%v, %v), expected (nil, false)", v[0], v[1], s, ok)
+		}
+	}
+
+	if s := Forms("ben"); !reflect.DeepEqual(s, []inf.Stem{inf.Stem("bana"), inf.Stem("benim")}) {
+		t.Errorf("Forms(ben) = %v, expected [bana benim]", s)
+	}
+}
+
+func TestTakesGenitive(t *testing.T) {
+	valid := [][2]string{{"ben", "CASE.INS"}, {"sen", "CASE.INS"}, {"o", "CASE.INS"}, {"kim", "CASE.INS"}}
+	for _, v := range valid {
+		if !TakesGenitive(v[0], v[1]) {
+			t.Errorf("TakesGenitive(%s, %s) = false, expected true", v[0], v[1])
+		}
+	}
+
+	invalid := [][2]string{{"ben", "CASE.DAT"}, {"ne", "CASE.INS"}, {"kendi", "CASE.INS"}, {"ev", "CASE.INS"}}
+	for _, v := range invalid {
+		if TakesGenitive(v[0], v[1]) {
+			t.Errorf("TakesGenitive(%s, %s) = true, expected false", v[0], v[1])
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/kaan9/turkish-morphology/reference"
+)
+
+/*
+reference [-data DIR] [-format json|html]
+Prints the reference of the suffix catalog with the allomorphs and examples of every suffix (see package reference)
+*/
+func referenceCmd(args []string) {
+	fs := flag.NewFlagSet("reference", flag.ExitOnError)
+	data := fs.String("data", ".", "directory of the data files")
+	format := fs.String("format", "json", "output format: json or html")
+	fs.Parse(args)
+
+	ref, err := reference.Load(*data)
+	if err != nil {
+		fatal(err)
+	}
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	switch *format {
+	case "json":
+		err = ref.WriteJSON(out)
+	case "html":
+		err = ref.WriteHTML(out)
+	default:
+		err = fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		out.Flush()
+		fatal(err)
+	}
+}
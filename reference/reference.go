@@ -0,0 +1,320 @@
+package reference
+
+import (
+	"bufio"
+	"encoding/json"
+	"html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/* A Reference documents every suffix of a catalog, in the sorted order of the tags */
+type Reference struct {
+	Suffixes []Suffix `json:"suffixes"`
+}
+
+/*
+A Suffix is documented by its tag, its form as written in the catalog, the description of the catalog's
+comments, the parts of speech it derives (see analysis.Derivation), its allomorphs and example words.
+*/
+type Suffix struct {
+	Tag         string      `json:"tag"`
+	Form        string      `json:"form"`
+	Description string      `json:"description,omitempty"`
+	Result      string      `json:"result,omitempty"`
+	Host        string      `json:"host,omitempty"`
+	Allomorphs  []Allomorph `json:"allomorphs"`
+	Examples    []Example   `json:"examples"`
+}
+
+/*
+An Allomorph is a realization of a suffix and the stem classes it is realized after. A stem class is named
+by a sample stem showing its last vowel and whether it ends in that vowel, a voiced or a voiceless
+consonant: ka, kal, kat. The allomorph is the part of the word that is not the stem, so a vowel dropped
+from the stem is not shown: ka + Iyor -> kıyor, the allomorph ıyor.
+*/
+type Allomorph struct {
+	Form    string   `json:"form"`
+	Classes []string `json:"classes"`
+}
+
+/* An Example is a word generated with the suffix from a sample root and its analysis */
+type Example struct {
+	Word     string `json:"word"`
+	Analysis string `json:"analysis"`
+}
+
+/* the sample stems naming the stem classes of allomorphs */
+var classes []string
+
+func init() {
+	for _, v := range "aeıioöuü" {
+		classes = append(classes, "k"+string(v), "k"+string(v)+"l", "k"+string(v)+"t")
+	}
+}
+
+/* A Sample is a list of roots of the lexicon of a part of speech */
+type Sample struct {
+	POS    string
+	Lemmas []string
+}
+
+/* the roots that examples are generated from, in order of preference */
+var Samples = []Sample{
+	{"NOUN", []string{"ev", "kitap", "araba", "göz"}},
+	{"VERB", []string{"gel", "yap", "oku", "gör"}},
+	{"ADJ", []string{"güzel", "büyük"}},
+	{"NUM", []string{"iki", "üç"}},
+	{"PRON", []string{"ben", "o"}},
+	{"ADV", []string{"yukarı", "az"}},
+}
+
+/* the maximum number of examples of a suffix */
+const MaxExamples = 3
+
+/*
+Builds the reference of the catalog of the analyzer. The descriptions are read from the comments of the
+catalog file (see Comments) and may be nil. Examples are generated from the Samples of the part of speech
+that reaches the suffix with the fewest nonempty suffixes, in the order of Samples.
+*/
+func Build(an *analysis.Analyzer, descriptions map[string]string) *Reference {
+	ref := &Reference{}
+	for _, tag := range an.Catalog.Tags() {
+		suf, _ := an.Catalog.Suffix(tag)
+		s := Suffix{Tag: tag, Form: suf.String(), Description: descriptions[tag]}
+		s.Result, s.Host, _ = analysis.Derivation(tag)
+		s.Allomorphs = allomorphs(suf)
+		s.Examples = examples(an, tag)
+		ref.Suffixes = append(ref.Suffixes, s)
+	}
+	return ref
+}
+
+/* builds the reference of the data files in dir, see analysis.Load */
+func Load(dir string) (*Reference, error) {
+	an, err := analysis.Load(dir)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(filepath.Join(dir, analysis.CatalogFile))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	descriptions, err := Comments(f)
+	if err != nil {
+		return nil, err
+	}
+	return Build(an, descriptions), nil
+}
+
+/* returns the realizations of the suffix after each stem class, in the order of their first class */
+func allomorphs(suf inf.Suffix) []Allomorph {
+	var res []Allomorph
+	index := map[string]int{}
+	for _, class := range classes {
+		stem := inf.Stem(class)
+		w := stem.Append(suf).Word()
+		i := 0
+		for i < len(w) && i < len(stem) && w[i] == stem[i] {
+			i++
+		}
+		form := string(w[i:])
+		if j, ok := index[form]; ok {
+			res[j].Classes = append(res[j].Classes, class)
+			continue
+		}
+		index[form] = len(res)
+		res = append(res, Allomorph{Form: form, Classes: []string{class}})
+	}
+	return res
+}
+
+/* generates up to MaxExamples words with the suffix from the Samples */
+func examples(an *analysis.Analyzer, tag string) []Example {
+	type path struct {
+		Sample
+		tags []string
+		len  int
+	}
+	var paths []path
+	for _, s := range Samples {
+		if tags, ok := chain(an, s.POS+".ROOT", tag); ok {
+			p := path{s, tags, 0}
+			for _, tag := range tags {
+				if !empty(an, tag) {
+					p.len++
+				}
+			}
+			paths = append(paths, p)
+		}
+	}
+	sort.SliceStable(paths, func(i, j int) bool { return paths[i].len < paths[j].len })
+	res := []Example{}
+	seen := map[string]bool{}
+	for _, p := range paths {
+		for _, lemma := range p.Lemmas {
+			for _, e := range an.Entries(lemma, p.POS) {
+				a, ok := an.Generate(e, p.tags)
+				if !ok || seen[a.Word.String()] {
+					continue
+				}
+				seen[a.Word.String()] = true
+				res = append(res, Example{Word: a.Word.String(), Analysis: a.String()})
+				if len(res) == MaxExamples {
+					return res
+				}
+			}
+		}
+	}
+	return res
+}
+
+/*
+Returns the shortest chain of tags of a word from the state that includes the tag: the shortest path
+of the morphotactics to the tag followed by the shortest path from the tag to a state where a word may end
+*/
+func chain(an *analysis.Analyzer, from, tag string) ([]string, bool) {
+	to, ok := shortest(an, from, func(state string) bool { return state == tag })
+	if !ok {
+		return nil, false
+	}
+	end, ok := shortest(an, tag, an.Tactics.Final)
+	if !ok {
+		return nil, false
+	}
+	return append(to, end...), true
+}
+
+/*
+Returns the tags of the shortest path of the morphotactics from a state to a state accepted by goal.
+The length of a path is its number of nonempty suffixes, so that evler is preferred to evlerden.
+*/
+func shortest(an *analysis.Analyzer, from string, goal func(state string) bool) ([]string, bool) {
+	dist := map[string]int{from: 0}
+	prev := map[string]string{}
+	done := map[string]bool{}
+	/* a 0-1 breadth first search: states reached by an empty suffix are visited before the others */
+	deque := []string{from}
+	for len(deque) > 0 {
+		state := deque[0]
+		deque = deque[1:]
+		if done[state] {
+			continue
+		}
+		done[state] = true
+		if goal(state) {
+			var tags []string
+			for ; state != from; state = prev[state] {
+				tags = append([]string{state}, tags...)
+			}
+			return tags, true
+		}
+		for _, tag := range an.Tactics.Next(state) {
+			e := empty(an, tag)
+			d := dist[state] + 1
+			if e {
+				d--
+			}
+			if old, ok := dist[tag]; ok && old <= d {
+				continue
+			}
+			dist[tag], prev[tag] = d, state
+			if e {
+				deque = append([]string{tag}, deque...)
+			} else {
+				deque = append(deque, tag)
+			}
+		}
+	}
+	return nil, false
+}
+
+/* reports whether the suffix of the tag is empty (CASE.ABSL) */
+func empty(an *analysis.Analyzer, tag string) bool {
+	suf, _ := an.Catalog.Suffix(tag)
+	return len(suf.Body) == 0 && suf.Head == 0 && suf.Tail == 0
+}
+
+/*
+Reads the descriptions of suffixes and their tables from the comments of a catalog in the format of
+suffixes.toml: the comment after a suffix or table header, or else the comments on the lines just before it
+*/
+func Comments(r io.Reader) (map[string]string, error) {
+	descriptions := map[string]string{}
+	table := ""
+	var above []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		code, comment := line, ""
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			code, comment = strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+		}
+		var tag string
+		switch {
+		case code == "":
+			if comment == "" {
+				above = nil
+			} else {
+				above = append(above, comment)
+			}
+			continue
+		case strings.HasPrefix(code, "[") && strings.HasSuffix(code, "]"):
+			table = strings.TrimSpace(code[1 : len(code)-1])
+			tag = table
+		case strings.Contains(code, "="):
+			tag = strings.TrimSpace(code[:strings.IndexByte(code, '=')])
+			if table != "" {
+				tag = table + "." + tag
+			}
+		}
+		if comment == "" {
+			comment = strings.Join(above, " ")
+		}
+		if tag != "" && comment != "" {
+			descriptions[tag] = comment
+		}
+		above = nil
+	}
+	return descriptions, scanner.Err()
+}
+
+/* writes the reference as indented JSON */
+func (ref *Reference) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(ref)
+}
+
+var page = template.Must(template.New("reference").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Suffix reference</title>
+</head>
+<body>
+<table>
+<tr><th>Tag</th><th>Suffix</th><th>Description</th><th>Allomorphs</th><th>Examples</th></tr>
+{{range .Suffixes}}<tr id="{{.Tag}}">
+<td>{{.Tag}}</td>
+<td>{{.Form}}</td>
+<td>{{.Description}}{{if .Result}} ({{.Result}} from {{.Host}}){{end}}</td>
+<td>{{range .Allomorphs}}<span title="{{range $i, $c := .Classes}}{{if $i}} {{end}}{{$c}}{{end}}">-{{.Form}}</span> {{end}}</td>
+<td>{{range .Examples}}<span title="{{.Analysis}}">{{.Word}}</span> {{end}}</td>
+</tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+/* writes the reference as an HTML page with a table of the suffixes */
+func (ref *Reference) WriteHTML(w io.Writer) error {
+	return page.Execute(w, ref)
+}
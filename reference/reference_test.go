@@ -0,0 +1,128 @@
+package reference
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func load(t *testing.T) *Reference {
+	ref, err := Load("..")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	return ref
+}
+
+func find(ref *Reference, tag string) Suffix {
+	for _, s := range ref.Suffixes {
+		if s.Tag == tag {
+			return s
+		}
+	}
+	return Suffix{}
+}
+
+func TestComments(t *testing.T) {
+	toml := `# plural
+PL = "lAr"
+
+INT = "mI"            # interrogative
+
+[POS] # possessive
+  1sg = "(I)m"
+  # comment on a line of its own
+  2sg = "(I)n"
+  3sg = "(s)I(n)"
+  [POS.X]
+    Y = "lI" # has a # in it
+`
+	valid_out := map[string]string{
+		"PL":      "plural",
+		"INT":     "interrogative",
+		"POS":     "possessive",
+		"POS.2sg": "comment on a line of its own",
+		"POS.X.Y": "has a # in it",
+	}
+	descriptions, err := Comments(strings.NewReader(toml))
+	if err != nil || !reflect.DeepEqual(descriptions, valid_out) {
+		t.Errorf("Comments() = (%v, %v), expected (%v, nil)", descriptions, err, valid_out)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	ref := load(t)
+	valid := []string{"PL", "CASE.LOC", "TAM.PPFV.KNWN", "N.V.MAN", "NUM.ORD"}
+	valid_out := []Suffix{
+		{
+			Tag: "PL", Form: "lAr", Description: "plural",
+			Allomorphs: []Allomorph{
+				{"lar", []string{"ka", "kal", "kat", "kı", "kıl", "kıt", "ko", "kol", "kot", "ku", "kul", "kut"}},
+				{"ler", []string{"ke", "kel", "ket", "ki", "kil", "kit", "kö", "köl", "köt", "kü", "kül", "küt"}},
+			},
+			Examples: []Example{
+				{"evler", "ev[NOUN]+PL+CASE.ABSL"},
+				{"kitaplar", "kitap[NOUN]+PL+CASE.ABSL"},
+				{"arabalar", "araba[NOUN]+PL+CASE.ABSL"},
+			},
+		},
+		{
+			Tag: "CASE.LOC", Form: "DA",
+			Allomorphs: []Allomorph{
+				{"da", []string{"ka", "kal", "kı", "kıl", "ko", "kol", "ku", "kul"}},
+				{"ta", []string{"kat", "kıt", "kot", "kut"}},
+				{"de", []string{"ke", "kel", "ki", "kil", "kö", "köl", "kü", "kül"}},
+				{"te", []string{"ket", "kit", "köt", "küt"}},
+			},
+			Examples: []Example{
+				{"evde", "ev[NOUN]+CASE.LOC"},
+				{"kitapta", "kitap[NOUN]+CASE.LOC"},
+				{"arabada", "araba[NOUN]+CASE.LOC"},
+			},
+		},
+	}
+	for i, s := range valid_out {
+		if got := find(ref, valid[i]); !reflect.DeepEqual(got, s) {
+			t.Errorf("Build() %s = %+v, expected %+v", valid[i], got, s)
+		}
+	}
+
+	examples := [][]string{{"geldi", "yaptı", "okudu"}, {"gelmen", "yapman", "okuman"}, {"ikinci", "üçüncü"}}
+	for i, ws := range examples {
+		s := find(ref, valid[i+2])
+		var got []string
+		for _, e := range s.Examples {
+			got = append(got, e.Word)
+		}
+		if !reflect.DeepEqual(got, ws) {
+			t.Errorf("Build() examples of %s = %v, expected %v", s.Tag, got, ws)
+		}
+	}
+	if s := find(ref, "N.V.MAN"); s.Result != "NOUN" || s.Host != "VERB" {
+		t.Errorf("Build() N.V.MAN derives %s from %s, expected NOUN from VERB", s.Result, s.Host)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	ref := load(t)
+	var b bytes.Buffer
+	if err := ref.WriteJSON(&b); err != nil {
+		t.Fatalf("WriteJSON() error: %v", err)
+	}
+	var read Reference
+	if err := json.Unmarshal(b.Bytes(), &read); err != nil || !reflect.DeepEqual(&read, ref) {
+		t.Errorf("WriteJSON() does not read back: %v", err)
+	}
+
+	b.Reset()
+	if err := ref.WriteHTML(&b); err != nil {
+		t.Fatalf("WriteHTML() error: %v", err)
+	}
+	for _, s := range []string{`<tr id="CASE.LOC">`, `<span title="ev[NOUN]&#43;CASE.LOC">evde</span>`, `-lar`} {
+		if !strings.Contains(b.String(), s) {
+			t.Errorf("WriteHTML() does not contain %s", s)
+		}
+	}
+}
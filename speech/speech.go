@@ -0,0 +1,130 @@
+package speech
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+	inf "github.com/kaan9/turkish-morphology/inflection"
+)
+
+/* a speech act */
+type Act int
+
+const (
+	Command    Act = iota /* gel, gelin, lütfen geliniz */
+	Request               /* gelir misin?, gelir misiniz?, gelebilir misiniz? */
+	Suggestion            /* gelelim mi?, gelsek nasıl olur?, gelmeye ne dersiniz? */
+)
+
+/* how polite a sentence is, which selects the person and the construction of each act */
+type Politeness int
+
+const (
+	Familiar Politeness = iota /* to one person addressed as sen */
+	Polite                     /* to one or more people addressed as siz */
+	Formal                     /* to strangers and in writing */
+)
+
+/*
+A template of a speech act: the words before the verb, the tags of the verb, the personal suffix of the
+interrogative particle mI after the verb (no particle if empty, the bare mi if "-") and the words after it.
+*/
+type template struct {
+	before   string
+	tags     []string
+	question string
+	after    string
+}
+
+/* the templates by act and politeness; the tag AOR is the aorist of the verb (see analysis.Aorist) */
+var templates = map[Act][3]template{
+	Command: {
+		{tags: []string{"IMP.2sg"}},
+		{tags: []string{"IMP.2pl"}},
+		{before: "lütfen ", tags: []string{"IMP.2pl2"}},
+	},
+	Request: {
+		{tags: []string{"AOR", "PRED.3sg"}, question: "PRED.2sg", after: "?"},
+		{tags: []string{"AOR", "PRED.3sg"}, question: "PRED.2pl", after: "?"},
+		{tags: []string{"VSX.ABIL", "TAM.AOR.I", "PRED.3sg"}, question: "PRED.2pl", after: "?"},
+	},
+	Suggestion: {
+		{tags: []string{"OPT.1pl"}, question: "-", after: "?"},
+		{tags: []string{"TAM.COND", "VB.1pl"}, after: " nasıl olur?"},
+		{tags: []string{"GER", "CASE.DAT"}, after: " ne dersiniz?"},
+	},
+}
+
+/* the interrogative particle, which takes the vowel of the word before it */
+var particle = inf.Suffix{Body: []rune("mI")}
+
+/* A Generator writes speech acts with the verbs of the lexicon of an analyzer */
+type Generator struct {
+	Analyzer *analysis.Analyzer
+}
+
+/* creates a Generator using the analyzer */
+func New(an *analysis.Analyzer) *Generator {
+	return &Generator{Analyzer: an}
+}
+
+/*
+Returns the sentence of a speech act with a verb of the lexicon at a level of politeness, built from its
+imperative, aorist question (with the separate particle mI) or conditional:
+
+	           Familiar      Polite               Formal
+	Command    gel           gelin                lütfen geliniz
+	Request    gelir misin?  gelir misiniz?       gelebilir misiniz?
+	Suggestion gelelim mi?   gelsek nasıl olur?   gelmeye ne dersiniz?
+*/
+func (g *Generator) Say(verb string, act Act, p Politeness) (string, error) {
+	ts, ok := templates[act]
+	if !ok || p < Familiar || p > Formal {
+		return "", fmt.Errorf("speech: unknown act %d or politeness %d", act, p)
+	}
+	t := ts[p]
+	es := g.Analyzer.Entries(verb, "VERB")
+	if len(es) == 0 {
+		return "", fmt.Errorf("speech: %s[VERB] is not in the lexicon", verb)
+	}
+	tags := make([]string, len(t.tags))
+	for i, tag := range t.tags {
+		if tag == "AOR" {
+			tag = analysis.Aorist(es[0])
+		}
+		tags[i] = tag
+	}
+	a, ok := g.Analyzer.Generate(es[0], tags)
+	if !ok {
+		return "", fmt.Errorf("speech: cannot generate %s[VERB]+%s", verb, strings.Join(tags, "+"))
+	}
+	s := t.before + a.Word.String()
+	if t.question != "" {
+		q, err := g.question(a.Stems[len(a.Stems)-1], t.question)
+		if err != nil {
+			return "", err
+		}
+		s += " " + q
+	}
+	return s + t.after, nil
+}
+
+/* returns the interrogative particle after the stem with the personal suffix of the tag ("-" for none) */
+func (g *Generator) question(stem inf.Stem, tag string) (string, error) {
+	m := stem.Append(particle).Word()
+	lemma := string(m[len(m)-2:])
+	es := g.Analyzer.Entries(lemma, "QUES")
+	if len(es) == 0 {
+		return "", fmt.Errorf("speech: %s[QUES] is not in the lexicon", lemma)
+	}
+	var tags []string
+	if tag != "-" {
+		tags = []string{tag}
+	}
+	a, ok := g.Analyzer.Generate(es[0], tags)
+	if !ok {
+		return "", fmt.Errorf("speech: cannot generate %s[QUES]+%s", lemma, tag)
+	}
+	return a.Word.String(), nil
+}
@@ -0,0 +1,72 @@
+package speech
+
+import (
+	"testing"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+)
+
+func load(t *testing.T) *Generator {
+	an, err := analysis.Load("..")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	return New(an)
+}
+
+func TestSay(t *testing.T) {
+	g := load(t)
+	acts := []Act{Command, Request, Suggestion}
+	valid := map[string][3][3]string{
+		"gel": {
+			{"gel", "gelin", "lütfen geliniz"},
+			{"gelir misin?", "gelir misiniz?", "gelebilir misiniz?"},
+			{"gelelim mi?", "gelsek nasıl olur?", "gelmeye ne dersiniz?"},
+		},
+		"yap": {
+			{"yap", "yapın", "lütfen yapınız"},
+			{"yapar mısın?", "yapar mısınız?", "yapabilir misiniz?"},
+			{"yapalım mı?", "yapsak nasıl olur?", "yapmaya ne dersiniz?"},
+		},
+		"oku": {
+			{"oku", "okuyun", "lütfen okuyunuz"},
+			{"okur musun?", "okur musunuz?", "okuyabilir misiniz?"},
+			{"okuyalım mı?", "okusak nasıl olur?", "okumaya ne dersiniz?"},
+		},
+		"gör": {
+			{"gör", "görün", "lütfen görünüz"},
+			{"görür müsün?", "görür müsünüz?", "görebilir misiniz?"},
+			{"görelim mi?", "görsek nasıl olur?", "görmeye ne dersiniz?"},
+		},
+		"ye": {
+			{"ye", "yiyin", "lütfen yiyiniz"},
+			{"yer misin?", "yer misiniz?", "yiyebilir misiniz?"},
+			{"yiyelim mi?", "yesek nasıl olur?", "yemeye ne dersiniz?"},
+		},
+		"de": {
+			{"de", "deyin", "lütfen deyiniz"},
+			{"der misin?", "der misiniz?", "diyebilir misiniz?"},
+			{"diyelim mi?", "desek nasıl olur?", "demeye ne dersiniz?"},
+		},
+	}
+	for verb, rows := range valid {
+		for i, act := range acts {
+			for p, expected := range rows[i] {
+				if s, err := g.Say(verb, act, Politeness(p)); err != nil || s != expected {
+					t.Errorf("Say(%s, %d, %d) = (%q, %v), expected %q", verb, act, p, s, err, expected)
+				}
+			}
+		}
+	}
+
+	invalid := []struct {
+		verb string
+		act  Act
+		p    Politeness
+	}{{"ev", Command, Familiar}, {"xyz", Request, Polite}, {"gel", Act(5), Familiar}, {"gel", Command, Politeness(3)}}
+	for _, v := range invalid {
+		if s, err := g.Say(v.verb, v.act, v.p); err == nil {
+			t.Errorf("Say(%s, %d, %d) = %q, expected an error", v.verb, v.act, v.p, s)
+		}
+	}
+}
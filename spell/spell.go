@@ -0,0 +1,105 @@
+package spell
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+	"github.com/kaan9/turkish-morphology/normalize"
+)
+
+/* the largest edit distance of a suggestion from a misspelled word used by New */
+const DefaultMaxDistance = 2
+
+/*
+A Checker checks the spelling of words with an analyzer: a word is spelled correctly when it has an analysis
+in its standard writing. MaxDistance is the largest edit distance of a suggestion from the word.
+*/
+type Checker struct {
+	Analyzer    *analysis.Analyzer
+	MaxDistance int
+}
+
+/* removes the apostrophes that may separate suffixes */
+var apostrophes = strings.NewReplacer("'", "", "’", "")
+
+/* creates a Checker using the analyzer with DefaultMaxDistance */
+func New(an *analysis.Analyzer) *Checker {
+	return &Checker{Analyzer: an, MaxDistance: DefaultMaxDistance}
+}
+
+/*
+Reports whether a word as written in text is spelled correctly: it has an analysis (see
+analysis.AnalyzeWritten) that is not a variant writing, so Ankara'da and kitapta are correct
+but Ankarada and kitap'ta are not
+*/
+func (c *Checker) Check(word string) bool {
+	for _, a := range c.Analyzer.AnalyzeWritten(word) {
+		if a.Variant == nil {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+Returns up to n corrections of a word as written in text, closest first: the standard writings of the word
+if it is a variant writing (Ankarada -> Ankara'da), then the words of the lexicon roots inflected by the
+morphotactics that are within MaxDistance of the word (kitaplerim -> kitaplarım), ranked by their
+inflection.Distance to it so that slips of harmony and voicing come first (kitapda -> kitapta). Since the
+suffixes of a candidate are appended to its root, they harmonize with it even where the word does not.
+Suggestions keep the capitalization of the word and proper nouns are capitalized and written with an apostrophe.
+*/
+func (c *Checker) Suggest(word string, n int) []string {
+	var sugs []string
+	seen := map[string]bool{}
+	add := func(s string) {
+		if !seen[s] && len(sugs) < n {
+			seen[s] = true
+			sugs = append(sugs, s)
+		}
+	}
+	first, _ := utf8.DecodeRuneInString(word)
+	capital := unicode.IsUpper(first)
+
+	for _, a := range c.Analyzer.AnalyzeWritten(word) {
+		if a.Variant != nil {
+			add(capitalize(a.Variant.Standard, capital || contains(a.Flags, analysis.Proper)))
+		}
+	}
+	plain := normalize.Lower(apostrophes.Replace(word))
+	for _, a := range c.Analyzer.Near(plain, c.MaxDistance) {
+		add(capitalize(c.write(a), capital || contains(a.Flags, analysis.Proper)))
+	}
+	return sugs
+}
+
+/* returns the standard writing of the word of an analysis, which places the apostrophe of proper nouns */
+func (c *Checker) write(a analysis.Analysis) string {
+	w := a.Word.String()
+	for _, b := range c.Analyzer.AnalyzeWritten(w) {
+		if b.String() == a.String() && b.Variant != nil {
+			return b.Variant.Standard
+		}
+	}
+	return w
+}
+
+/* capitalizes the first letter of s when capital is set */
+func capitalize(s string, capital bool) string {
+	if !capital || s == "" {
+		return s
+	}
+	r, size := utf8.DecodeRuneInString(s)
+	return normalize.Upper(string(r)) + s[size:]
+}
+
+func contains(xs []string, x string) bool {
+	for _, y := range xs {
+		if y == x {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package spell
+
+import (
+	"testing"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+)
+
+func load(t *testing.T) *Checker {
+	an, err := analysis.Load("..")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	return New(an)
+}
+
+func TestCheck(t *testing.T) {
+	c := load(t)
+	valid := []string{"ev", "evlerimizden", "Gidiyorum", "Ankara'da", "kitapta", "2'nci"}
+	for _, w := range valid {
+		if !c.Check(w) {
+			t.Errorf("Check(%s) = false, expected true", w)
+		}
+	}
+
+	invalid := []string{"kitaplerim", "gidiyorm", "Ankarada", "kitap'ta", "xyz", ""}
+	for _, w := range invalid {
+		if c.Check(w) {
+			t.Errorf("Check(%s) = true, expected false", w)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	c := load(t)
+	valid := []string{"kitaplerim", "evlrimiz", "gidiyorm", "Gidiyorm", "Ankarada", "istanbulda", "okuyucü", "kitapda", "ev"}
+	valid_out := []string{"kitaplarım", "evlerimiz", "gidiyorum", "Gidiyorum", "Ankara'da", "İstanbul'da", "okuyucu", "kitapta", "ev"}
+	for i, w := range valid {
+		if s := c.Suggest(w, 5); len(s) == 0 || s[0] != valid_out[i] {
+			t.Errorf("Suggest(%s, 5) = %v, expected to begin with %s", w, s, valid_out[i])
+		}
+	}
+
+	if s := c.Suggest("evlrimiz", 2); len(s) != 2 {
+		t.Errorf("Suggest(evlrimiz, 2) = %v, expected 2 suggestions", s)
+	}
+	if s := c.Suggest("xyzxyzxyz", 5); len(s) != 0 {
+		t.Errorf("Suggest(xyzxyzxyz, 5) = %v, expected no suggestions", s)
+	}
+}
+
+/* with a lenient analyzer an attached interrogative particle is misspelled and separated by the first suggestion */
+func TestSuggestQuestion(t *testing.T) {
+	c := load(t)
+	c.Analyzer.Options.Lenient = true
+	valid := []string{"geliyormusun", "Varmı", "evdemiyiz"}
+	valid_out := []string{"geliyor musun", "Var mı", "evde miyiz"}
+	for i, w := range valid {
+		if c.Check(w) {
+			t.Errorf("Check(%s) = true, expected false", w)
+		}
+		if s := c.Suggest(w, 3); len(s) == 0 || s[0] != valid_out[i] {
+			t.Errorf("Suggest(%s, 3) = %v, expected to begin with %s", w, s, valid_out[i])
+		}
+	}
+	/* a plural predicate agrees with its subject: onlar akrabalar */
+	if !c.Check("akrabalar") {
+		t.Errorf("Check(akrabalar) = false with a prescriptive analyzer, expected true")
+	}
+}
+
+/* with a prescriptive analyzer the double plural of a foreign plural is misspelled and corrected to the singular */
+func TestSuggestForeignPlural(t *testing.T) {
+	c := load(t)
+	if !c.Check("evraklar") {
+		t.Errorf("Check(evraklar) = false, expected true")
+	}
+	c.Analyzer.Options.ForeignPlurals = analysis.Prescriptive
+	valid := []string{"evraklarda", "Eşyalarımızı", "akrabalarda"}
+	valid_out := []string{"evrakta", "Eşyamızı", "akrabada"}
+	for i, w := range valid {
+		if c.Check(w) {
+			t.Errorf("Check(%s) = true, expected false", w)
+		}
+		if s := c.Suggest(w, 3); len(s) == 0 || s[0] != valid_out[i] {
+			t.Errorf("Suggest(%s, 3) = %v, expected to begin with %s", w, s, valid_out[i])
+		}
+	}
+	/* a plural predicate agrees with its subject: onlar akrabalar */
+	if !c.Check("akrabalar") {
+		t.Errorf("Check(akrabalar) = false with a prescriptive analyzer, expected true")
+	}
+}
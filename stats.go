@@ -0,0 +1,69 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kaan9/turkish-morphology/analysis"
+	"github.com/kaan9/turkish-morphology/tokenize"
+)
+
+/*
+stats [-data DIR] [-n N] [FILE...]
+Analyzes every word of the corpus files (or stdin) and reports the frequencies of roots, suffix tags,
+suffix transitions and whole suffix chains, in the format read by analysis.ReadStats
+*/
+func statsCmd(args []string) {
+	fs := flag.NewFlagSet("stats", flag.ExitOnError)
+	data := fs.String("data", ".", "directory of the data files")
+	n := fs.Int("n", 0, "only report the `n` most frequent entries of each kind (0 reports all)")
+	fs.Parse(args)
+
+	an, err := analysis.Load(*data)
+	if err != nil {
+		fatal(err)
+	}
+	st := analysis.NewStats()
+	err = eachInput(fs.Args(), func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			for _, w := range tokenize.Words(scanner.Text()) {
+				st.Add(an.Analyze(w))
+			}
+		}
+		return scanner.Err()
+	})
+	if err != nil {
+		fatal(err)
+	}
+	if err := st.Write(os.Stdout, *n); err != nil {
+		fatal(err)
+	}
+}
+
+/* calls f on each of the named files, or on stdin if there are none */
+func eachInput(files []string, f func(r io.Reader) error) error {
+	if len(files) == 0 {
+		return f(os.Stdin)
+	}
+	for _, name := range files {
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		err = f(file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,189 @@
+package tokenize
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/kaan9/turkish-morphology/normalize"
+)
+
+/* the kind of a token */
+type Kind int
+
+const (
+	Word     Kind = iota
+	Number        /* digits, possibly with decimal or thousands separators: 3, 1960, 3,5, 1.000.000 */
+	Particle      /* the interrogative particle mI written separately, with its suffixes: mi, misin, mıydı */
+	Punct         /* a punctuation mark or a run of the same mark: . , ... ?! */
+)
+
+/*
+A Token is a word, number, particle or punctuation mark of running text. Pos is its byte offset in the
+text. Suffixes attached with an apostrophe (Ankara'da, 3'te, 1960'larda), or directly to a number (2nci),
+are part of the token and are split into Stem and Suffix, otherwise Stem is the whole text and Suffix is
+empty. Words joined by hyphens (yapa-yapa) are a single token.
+*/
+type Token struct {
+	Text   string
+	Kind   Kind
+	Pos    int
+	Stem   string
+	Suffix string
+}
+
+/* the apostrophes used to attach suffixes: ASCII and typographic */
+var apostrophes = "'’"
+
+/* the separate interrogative particle with its copulas and personal suffixes */
+var particle = regexp.MustCompile(`^m[ıiuü](?:` +
+	`y[ıiuü]m|s[ıiuü]n|y[ıiuü]z|s[ıiuü]n[ıiuü]z|y[ae]n|yken|` +
+	`(?:yd[ıiuü]|ys[ae])(?:m|n|k|n[ıiuü]z|lar|ler)?|` +
+	`ym[ıiuü]ş(?:[ıiuü]m|s[ıiuü]n|[ıiuü]z|s[ıiuü]n[ıiuü]z|lar|ler)?)?$`)
+
+/* splits running text into tokens, dropping whitespace */
+func Tokenize(s string) []Token {
+	var toks []Token
+	for i := 0; i < len(s); {
+		c, size := utf8.DecodeRuneInString(s[i:])
+		var end int
+		kind := Word
+		switch {
+		case unicode.IsSpace(c):
+			i += size
+			continue
+		case unicode.IsLetter(c):
+			end = scanWord(s, i)
+		case unicode.IsDigit(c):
+			kind = Number
+			end = scanNumber(s, i)
+		default:
+			kind = Punct
+			end = i + size
+			for end < len(s) && strings.HasPrefix(s[end:], string(c)) {
+				end += size
+			}
+			if c == '?' || c == '!' {
+				end = scan(s, i, func(r rune) bool { return r == '?' || r == '!' })
+			}
+		}
+
+		tok := Token{Text: s[i:end], Kind: kind, Pos: i, Stem: s[i:end]}
+		/* letters directly after a number are its suffix, written without an apostrophe: 2nci */
+		if kind == Number {
+			if send := scan(s, end, letter); send > end {
+				tok.Suffix = s[end:send]
+				tok.Text = s[i:send]
+				end = send
+			}
+		}
+		/* an apostrophe followed by letters attaches a suffix */
+		if kind != Punct && tok.Suffix == "" && end < len(s) {
+			if a, asize := utf8.DecodeRuneInString(s[end:]); strings.ContainsRune(apostrophes, a) {
+				if r, _ := utf8.DecodeRuneInString(s[end+asize:]); unicode.IsLetter(r) {
+					send := scan(s, end+asize, letter)
+					tok.Suffix = s[end+asize : send]
+					tok.Text = s[i:send]
+					end = send
+				}
+			}
+		}
+		if kind == Word && particle.MatchString(tok.Form()) {
+			tok.Kind = Particle
+		}
+		toks = append(toks, tok)
+		i = end
+	}
+	return toks
+}
+
+/* returns the end of the run of runes satisfying f starting at i */
+func scan(s string, i int, f func(rune) bool) int {
+	for i < len(s) {
+		c, size := utf8.DecodeRuneInString(s[i:])
+		if !f(c) {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+/* reports whether r belongs to a word: a letter or a combining mark of a decomposed letter (s + U+0327) */
+func letter(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsMark(r)
+}
+
+/* returns the end of the word starting at i, including hyphens that are between letters (yapa-yapa) */
+func scanWord(s string, i int) int {
+	end := scan(s, i, letter)
+	for end+1 < len(s) && s[end] == '-' {
+		if c, _ := utf8.DecodeRuneInString(s[end+1:]); !unicode.IsLetter(c) {
+			break
+		}
+		end = scan(s, end+1, letter)
+	}
+	return end
+}
+
+/* returns the end of the number starting at i, including separators that are between digits */
+func scanNumber(s string, i int) int {
+	end := scan(s, i, unicode.IsDigit)
+	for end+1 < len(s) && (s[end] == '.' || s[end] == ',') && s[end+1] >= '0' && s[end+1] <= '9' {
+		end = scan(s, end+1, unicode.IsDigit)
+	}
+	return end
+}
+
+/*
+Returns the form of the token given to the analyzer: normalized and lowercased (see normalize.Lower)
+and with the apostrophe removed, e.g. Ankara'da -> ankarada
+*/
+func (t Token) Form() string {
+	return normalize.Lower(t.Stem + t.Suffix)
+}
+
+/*
+Reports whether the tokens a and b of s, with b following a, are the halves of a reduplication written with
+spaces: the same word twice, as in koşa koşa or güle güle (see Join). A hyphenated reduplication (yapa-yapa)
+is already a single token.
+*/
+func Reduplication(s string, a, b Token) bool {
+	if a.Kind != Word || b.Kind != Word || a.Suffix != "" || b.Suffix != "" || a.Form() != b.Form() {
+		return false
+	}
+	gap := s[a.Pos+len(a.Text) : b.Pos]
+	return gap != "" && strings.Trim(gap, " ") == ""
+}
+
+/* returns the single token of s spanning the tokens a and b and the text between them: koşa koşa */
+func Join(s string, a, b Token) Token {
+	text := s[a.Pos : b.Pos+len(b.Text)]
+	return Token{Text: text, Kind: a.Kind, Pos: a.Pos, Stem: text}
+}
+
+/* the words (including particles) of running text, in the form given to the analyzer */
+func Words(s string) []string {
+	var ws []string
+	for _, t := range Tokenize(s) {
+		if t.Kind == Word || t.Kind == Particle {
+			ws = append(ws, t.Form())
+		}
+	}
+	return ws
+}
+
+func (k Kind) String() string {
+	switch k {
+	case Word:
+		return "Word"
+	case Number:
+		return "Number"
+	case Particle:
+		return "Particle"
+	case Punct:
+		return "Punct"
+	}
+	return "Kind(?)"
+}
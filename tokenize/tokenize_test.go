@@ -0,0 +1,112 @@
+package tokenize
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	valid := []string{
+		"Ankara'da 3'te buluşalım, tamam mı?",
+		"1960’larda İstanbul'un nüfusu 1.500.000 idi...",
+		"Geliyor musunuz?! Gelecek miydiniz",
+		"mısır mum misin mis mıymışlar",
+		"  3,5 kg'lık  ",
+		"2nci koşa-koşa -gel- a-",
+		"",
+	}
+	valid_out := [][]Token{
+		[]Token{
+			Token{Text: "Ankara'da", Kind: Word, Pos: 0, Stem: "Ankara", Suffix: "da"},
+			Token{Text: "3'te", Kind: Number, Pos: 10, Stem: "3", Suffix: "te"},
+			Token{Text: "buluşalım", Kind: Word, Pos: 15, Stem: "buluşalım"},
+			Token{Text: ",", Kind: Punct, Pos: 26, Stem: ","},
+			Token{Text: "tamam", Kind: Word, Pos: 28, Stem: "tamam"},
+			Token{Text: "mı", Kind: Particle, Pos: 34, Stem: "mı"},
+			Token{Text: "?", Kind: Punct, Pos: 37, Stem: "?"},
+		},
+		[]Token{
+			Token{Text: "1960’larda", Kind: Number, Pos: 0, Stem: "1960", Suffix: "larda"},
+			Token{Text: "İstanbul'un", Kind: Word, Pos: 13, Stem: "İstanbul", Suffix: "un"},
+			Token{Text: "nüfusu", Kind: Word, Pos: 26, Stem: "nüfusu"},
+			Token{Text: "1.500.000", Kind: Number, Pos: 34, Stem: "1.500.000"},
+			Token{Text: "idi", Kind: Word, Pos: 44, Stem: "idi"},
+			Token{Text: "...", Kind: Punct, Pos: 47, Stem: "..."},
+		},
+		[]Token{
+			Token{Text: "Geliyor", Kind: Word, Pos: 0, Stem: "Geliyor"},
+			Token{Text: "musunuz", Kind: Particle, Pos: 8, Stem: "musunuz"},
+			Token{Text: "?!", Kind: Punct, Pos: 15, Stem: "?!"},
+			Token{Text: "Gelecek", Kind: Word, Pos: 18, Stem: "Gelecek"},
+			Token{Text: "miydiniz", Kind: Particle, Pos: 26, Stem: "miydiniz"},
+		},
+		[]Token{
+			Token{Text: "mısır", Kind: Word, Pos: 0, Stem: "mısır"},
+			Token{Text: "mum", Kind: Word, Pos: 8, Stem: "mum"},
+			Token{Text: "misin", Kind: Particle, Pos: 12, Stem: "misin"},
+			Token{Text: "mis", Kind: Word, Pos: 18, Stem: "mis"},
+			Token{Text: "mıymışlar", Kind: Particle, Pos: 22, Stem: "mıymışlar"},
+		},
+		[]Token{
+			Token{Text: "3,5", Kind: Number, Pos: 2, Stem: "3,5"},
+			Token{Text: "kg'lık", Kind: Word, Pos: 6, Stem: "kg", Suffix: "lık"},
+		},
+		[]Token{
+			Token{Text: "2nci", Kind: Number, Pos: 0, Stem: "2", Suffix: "nci"},
+			Token{Text: "koşa-koşa", Kind: Word, Pos: 5, Stem: "koşa-koşa"},
+			Token{Text: "-", Kind: Punct, Pos: 17, Stem: "-"},
+			Token{Text: "gel", Kind: Word, Pos: 18, Stem: "gel"},
+			Token{Text: "-", Kind: Punct, Pos: 21, Stem: "-"},
+			Token{Text: "a", Kind: Word, Pos: 23, Stem: "a"},
+			Token{Text: "-", Kind: Punct, Pos: 24, Stem: "-"},
+		},
+		nil,
+	}
+	for i, s := range valid {
+		if toks := Tokenize(s); !reflect.DeepEqual(toks, valid_out[i]) {
+			t.Errorf("Tokenize(%q) = %v, expected %v", s, toks, valid_out[i])
+		}
+	}
+}
+
+func TestWords(t *testing.T) {
+	valid := []string{
+		"İstanbul'da IŞIK var mı? 3'te.",
+		"Ankara’ya, İzmir'e",
+		"I\u0307stanbul'da c\u0327ocuk S\u0327EKER",
+	}
+	valid_out := [][]string{
+		[]string{"istanbulda", "ışık", "var", "mı"},
+		[]string{"ankaraya", "izmire"},
+		[]string{"istanbulda", "çocuk", "şeker"},
+	}
+	for i, s := range valid {
+		if ws := Words(s); !reflect.DeepEqual(ws, valid_out[i]) {
+			t.Errorf("Words(%q) = %q, expected %q", s, ws, valid_out[i])
+		}
+	}
+}
+
+func TestReduplication(t *testing.T) {
+	valid := []string{"koşa koşa", "Güle güle", "ağlaya  ağlaya geldi"}
+	valid_out := []Token{
+		Token{Text: "koşa koşa", Kind: Word, Pos: 0, Stem: "koşa koşa"},
+		Token{Text: "Güle güle", Kind: Word, Pos: 0, Stem: "Güle güle"},
+		Token{Text: "ağlaya  ağlaya", Kind: Word, Pos: 0, Stem: "ağlaya  ağlaya"},
+	}
+	for i, s := range valid {
+		toks := Tokenize(s)
+		if !Reduplication(s, toks[0], toks[1]) {
+			t.Errorf("Reduplication(%q) = false, expected true", s)
+		} else if tok := Join(s, toks[0], toks[1]); !reflect.DeepEqual(tok, valid_out[i]) {
+			t.Errorf("Join(%q) = %v, expected %v", s, tok, valid_out[i])
+		}
+	}
+
+	invalid := []string{"koşa koştu", "koşa, koşa", "koşa\nkoşa", "Ankara'da Ankara'da", "3 3"}
+	for _, s := range invalid {
+		if toks := Tokenize(s); Reduplication(s, toks[0], toks[1]) {
+			t.Errorf("Reduplication(%q) = true, expected false", s)
+		}
+	}
+}